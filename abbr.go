@@ -0,0 +1,104 @@
+package tz
+
+import (
+	"strings"
+)
+
+// PreferredZones is the zone ByAbbr() lists first for abbreviations that are
+// used by more than one zone.
+//
+// This is mostly the zone that people are most likely to mean; for example IST
+// is used for Asia/Kolkata (India Standard Time), Europe/Dublin (Irish Standard
+// Time), and Asia/Jerusalem (Israel Standard Time).
+var PreferredZones = map[string]string{
+	"ACDT": "Australia/Adelaide",
+	"ACST": "Australia/Adelaide",
+	"ADT":  "America/Halifax",
+	"AEDT": "Australia/Sydney",
+	"AEST": "Australia/Sydney",
+	"AKDT": "America/Anchorage",
+	"AKST": "America/Anchorage",
+	"AST":  "America/Halifax",
+	"BST":  "Europe/London",
+	"CAT":  "Africa/Maputo",
+	"CDT":  "America/Chicago",
+	"CEST": "Europe/Brussels",
+	"CET":  "Europe/Brussels",
+	"CST":  "America/Chicago",
+	"EDT":  "America/New_York",
+	"EEST": "Europe/Athens",
+	"EET":  "Europe/Athens",
+	"EST":  "America/New_York",
+	"GMT":  "Europe/London",
+	"HST":  "Pacific/Honolulu",
+	"IST":  "Asia/Kolkata",
+	"KST":  "Asia/Seoul",
+	"MDT":  "America/Denver",
+	"MSK":  "Europe/Moscow",
+	"MST":  "America/Denver",
+	"PDT":  "America/Los_Angeles",
+	"PST":  "America/Los_Angeles",
+	"WAT":  "Africa/Lagos",
+	"WEST": "Europe/Lisbon",
+	"WET":  "Europe/Lisbon",
+	"WIB":  "Asia/Jakarta",
+}
+
+// ByAbbr gets all zones that use the abbreviation, such as "CEST" or "IST". The
+// abbreviation is case-insensitive.
+//
+// The zone in PreferredZones is listed first, followed by the rest in the same
+// order as Zones. Every zone is listed only once, with the first country for
+// that zone.
+//
+// The optional country code is used as a hint: only zones in that country are
+// returned if there are any, and all zones otherwise. For example "IST" with
+// "IE" returns only Europe/Dublin.
+//
+// Note that not every zone has an abbreviation; they're only listed if they're
+// in common use. Returns nil if nothing uses this abbreviation.
+func ByAbbr(abbr string, ccode ...string) []*Zone {
+	loadLocations()
+	abbr = strings.ToUpper(abbr)
+	zones := byAbbr[abbr]
+	if len(zones) == 0 {
+		return nil
+	}
+
+	if len(ccode) > 0 {
+		var inCountry []*Zone
+		for _, z := range zones {
+			for _, c := range ccode {
+				if z.CountryCode == c {
+					inCountry = append(inCountry, z)
+				}
+			}
+		}
+		if len(inCountry) > 0 {
+			zones = inCountry
+		}
+	}
+
+	var (
+		r    = make([]*Zone, 0, len(zones))
+		seen = make(map[string]struct{}, len(zones))
+		add  = func(z *Zone) {
+			if _, ok := seen[z.Zone]; !ok {
+				seen[z.Zone] = struct{}{}
+				r = append(r, z)
+			}
+		}
+	)
+	if p, ok := PreferredZones[abbr]; ok {
+		for _, z := range zones {
+			if z.Zone == p {
+				add(z)
+				break
+			}
+		}
+	}
+	for _, z := range zones {
+		add(z)
+	}
+	return r
+}
@@ -0,0 +1,55 @@
+package tz
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestByAbbr(t *testing.T) {
+	tests := []struct {
+		abbr   string
+		ccodes []string
+		want   string
+	}{
+		{"IST", nil, "[IN.Asia/Kolkata IE.Europe/Dublin IL.Asia/Jerusalem]"},
+		{"ist", nil, "[IN.Asia/Kolkata IE.Europe/Dublin IL.Asia/Jerusalem]"},
+		{"IST", []string{"IE"}, "[IE.Europe/Dublin]"},
+		{"IST", []string{"IL", "IE"}, "[IE.Europe/Dublin IL.Asia/Jerusalem]"},
+		{"IST", []string{"NL"}, "[IN.Asia/Kolkata IE.Europe/Dublin IL.Asia/Jerusalem]"},
+		{"BST", nil, "[GB.Europe/London]"},
+		{"BST", []string{"JE"}, "[JE.Europe/London]"},
+		{"WITA", nil, "[ID.Asia/Makassar]"},
+		{"WIB", nil, "[ID.Asia/Jakarta ID.Asia/Pontianak]"},
+		{"UTC", nil, "[.UTC]"},
+		{"XXX", nil, "[]"},
+		{"", nil, "[]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s%s", tt.abbr, tt.ccodes), func(t *testing.T) {
+			have := fmt.Sprint(ByAbbr(tt.abbr, tt.ccodes...))
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+
+	t.Run("CST", func(t *testing.T) {
+		z := ByAbbr("CST")
+		if z[0].Zone != "America/Chicago" {
+			t.Errorf("first is %s", z[0])
+		}
+		if len(z) < 20 {
+			t.Errorf("len is %d", len(z))
+		}
+	})
+}
+
+func TestPreferredZones(t *testing.T) {
+	for abbr, zone := range PreferredZones {
+		z := ByAbbr(abbr)
+		if len(z) == 0 || z[0].Zone != zone {
+			t.Errorf("%s: %s not used by or not first in %s", abbr, zone, z)
+		}
+	}
+}
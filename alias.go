@@ -1,16 +1,8 @@
 package tz
 
+// aliases maps zone names that aren't in Zones to a zone that is, or to an
+// Etc/ zone. Generated by gen.go from the Link lines in tzdata.zi.
 var aliases = map[string]string{
-	// Extracted from tzdb with:
-	//
-	// TODO: gen.go should generate this.
-	//
-	// egrep -h '^L(ink)? ' /usr/share/zoneinfo/tzdata.zi |
-	//   sed -E 's/\s+#.*//; s/\s+/ /g' |
-	//   sort -u |
-	//   sed -E 's/L(ink)? (.*?) (.*?)/"\2": "\1",/' |
-	//   sort -u
-
 	"Africa/Accra":                     "Africa/Abidjan",
 	"Africa/Addis_Ababa":               "Africa/Nairobi",
 	"Africa/Asmara":                    "Africa/Nairobi",
@@ -158,6 +150,7 @@ var aliases = map[string]string{
 	"Chile/EasterIsland":               "Pacific/Easter",
 	"Cuba":                             "America/Havana",
 	"EET":                              "Europe/Athens",
+	"EST":                              "America/Panama",
 	"EST5EDT":                          "America/New_York",
 	"Egypt":                            "Africa/Cairo",
 	"Eire":                             "Europe/Dublin",
@@ -218,6 +211,8 @@ var aliases = map[string]string{
 	"Japan":                            "Asia/Tokyo",
 	"Kwajalein":                        "Pacific/Kwajalein",
 	"Libya":                            "Africa/Tripoli",
+	"MET":                              "Europe/Brussels",
+	"MST":                              "America/Phoenix",
 	"MST7MDT":                          "America/Denver",
 	"Mexico/BajaNorte":                 "America/Tijuana",
 	"Mexico/BajaSur":                   "America/Mazatlan",
@@ -266,3 +261,113 @@ var aliases = map[string]string{
 	"WET":                              "Europe/Lisbon",
 	"Zulu":                             "Etc/UTC",
 }
+
+// aliasCountries maps aliases to the country they're listed for in zone.tab.
+var aliasCountries = map[string]string{
+	"Africa/Accra":              "GH",
+	"Africa/Addis_Ababa":        "ET",
+	"Africa/Asmara":             "ER",
+	"Africa/Bamako":             "ML",
+	"Africa/Bangui":             "CF",
+	"Africa/Banjul":             "GM",
+	"Africa/Blantyre":           "MW",
+	"Africa/Brazzaville":        "CG",
+	"Africa/Bujumbura":          "BI",
+	"Africa/Conakry":            "GN",
+	"Africa/Dakar":              "SN",
+	"Africa/Dar_es_Salaam":      "TZ",
+	"Africa/Djibouti":           "DJ",
+	"Africa/Douala":             "CM",
+	"Africa/Freetown":           "SL",
+	"Africa/Gaborone":           "BW",
+	"Africa/Harare":             "ZW",
+	"Africa/Kampala":            "UG",
+	"Africa/Kigali":             "RW",
+	"Africa/Kinshasa":           "CD",
+	"Africa/Libreville":         "GA",
+	"Africa/Lome":               "TG",
+	"Africa/Luanda":             "AO",
+	"Africa/Lubumbashi":         "CD",
+	"Africa/Lusaka":             "ZM",
+	"Africa/Malabo":             "GQ",
+	"Africa/Maseru":             "LS",
+	"Africa/Mbabane":            "SZ",
+	"Africa/Mogadishu":          "SO",
+	"Africa/Niamey":             "NE",
+	"Africa/Nouakchott":         "MR",
+	"Africa/Ouagadougou":        "BF",
+	"Africa/Porto-Novo":         "BJ",
+	"America/Anguilla":          "AI",
+	"America/Antigua":           "AG",
+	"America/Aruba":             "AW",
+	"America/Atikokan":          "CA",
+	"America/Blanc-Sablon":      "CA",
+	"America/Cayman":            "KY",
+	"America/Creston":           "CA",
+	"America/Curacao":           "CW",
+	"America/Dominica":          "DM",
+	"America/Grenada":           "GD",
+	"America/Guadeloupe":        "GP",
+	"America/Kralendijk":        "BQ",
+	"America/Lower_Princes":     "SX",
+	"America/Marigot":           "MF",
+	"America/Montserrat":        "MS",
+	"America/Nassau":            "BS",
+	"America/Port_of_Spain":     "TT",
+	"America/St_Barthelemy":     "BL",
+	"America/St_Kitts":          "KN",
+	"America/St_Lucia":          "LC",
+	"America/St_Thomas":         "VI",
+	"America/St_Vincent":        "VC",
+	"America/Tortola":           "VG",
+	"Antarctica/DumontDUrville": "AQ",
+	"Antarctica/McMurdo":        "AQ",
+	"Antarctica/Syowa":          "AQ",
+	"Arctic/Longyearbyen":       "SJ",
+	"Asia/Aden":                 "YE",
+	"Asia/Bahrain":              "BH",
+	"Asia/Brunei":               "BN",
+	"Asia/Kuala_Lumpur":         "MY",
+	"Asia/Kuwait":               "KW",
+	"Asia/Muscat":               "OM",
+	"Asia/Phnom_Penh":           "KH",
+	"Asia/Vientiane":            "LA",
+	"Atlantic/Reykjavik":        "IS",
+	"Atlantic/St_Helena":        "SH",
+	"Europe/Amsterdam":          "NL",
+	"Europe/Bratislava":         "SK",
+	"Europe/Busingen":           "DE",
+	"Europe/Copenhagen":         "DK",
+	"Europe/Guernsey":           "GG",
+	"Europe/Isle_of_Man":        "IM",
+	"Europe/Jersey":             "JE",
+	"Europe/Ljubljana":          "SI",
+	"Europe/Luxembourg":         "LU",
+	"Europe/Mariehamn":          "AX",
+	"Europe/Monaco":             "MC",
+	"Europe/Oslo":               "NO",
+	"Europe/Podgorica":          "ME",
+	"Europe/San_Marino":         "SM",
+	"Europe/Sarajevo":           "BA",
+	"Europe/Skopje":             "MK",
+	"Europe/Stockholm":          "SE",
+	"Europe/Vaduz":              "LI",
+	"Europe/Vatican":            "VA",
+	"Europe/Zagreb":             "HR",
+	"Indian/Antananarivo":       "MG",
+	"Indian/Christmas":          "CX",
+	"Indian/Cocos":              "CC",
+	"Indian/Comoro":             "KM",
+	"Indian/Kerguelen":          "TF",
+	"Indian/Mahe":               "SC",
+	"Indian/Mayotte":            "YT",
+	"Indian/Reunion":            "RE",
+	"Pacific/Chuuk":             "FM",
+	"Pacific/Funafuti":          "TV",
+	"Pacific/Majuro":            "MH",
+	"Pacific/Midway":            "UM",
+	"Pacific/Pohnpei":           "FM",
+	"Pacific/Saipan":            "MP",
+	"Pacific/Wake":              "UM",
+	"Pacific/Wallis":            "WF",
+}
@@ -0,0 +1,86 @@
+package tz
+
+import (
+	"time"
+)
+
+// AddDate adds years, months, and days to the wall clock time of tt in this
+// zone, keeping the time of day. This is different from adding 24*time.Hour on
+// days with a DST change: 10:00 plus one day is always 10:00 on the next day.
+//
+// Like time.AddDate(), the date is normalized: October 31 plus one month is
+// December 1.
+//
+// If the wall clock time doesn't exist because it's skipped by a DST change then
+// the time of the change is used. If it's ambiguous because the clock is set
+// back then the occurrence with the same offset as tt is used if there is one,
+// and the first occurrence otherwise; adding zero to the second 02:30 on the
+// day DST ends gives the same time.
+func (t *Zone) AddDate(tt time.Time, years, months, days int) time.Time {
+	tt = tt.In(t.Loc())
+	y, m, d := tt.Date()
+	earlier, later, s := wallTimes(y+years, m+time.Month(months), d+days, sinceMidnight(tt), t.Loc())
+	if s == Ambiguous {
+		_, off := tt.Zone()
+		if _, o := later.Zone(); o == off {
+			return later
+		}
+	}
+	r, _ := pick(earlier, later, s, ShiftForward)
+	return r
+}
+
+// StartOfDay gets the first instant of the day of tt in this zone.
+//
+// This is usually midnight, but some zones skip midnight when DST starts (such
+// as America/Santiago and Asia/Beirut), in which case the start of the day is
+// the time of the change: 01:00.
+func (t *Zone) StartOfDay(tt time.Time) time.Time {
+	y, m, d := tt.In(t.Loc()).Date()
+	return wallDate(y, m, d, 0, t.Loc())
+}
+
+// EndOfDay gets the last instant of the day of tt in this zone; this is one
+// nanosecond before the start of the next day.
+//
+// Days aren't always 24 hours; use StartOfDay(AddDate(tt, 0, 0, 1)) to get the
+// end of the day as an exclusive bound.
+func (t *Zone) EndOfDay(tt time.Time) time.Time {
+	y, m, d := tt.In(t.Loc()).Date()
+	return wallDate(y, m, d+1, 0, t.Loc()).Add(-1)
+}
+
+// StartOfWeek gets the first instant of the week of tt in this zone, with weeks
+// starting on the weekday.
+func (t *Zone) StartOfWeek(tt time.Time, weekday time.Weekday) time.Time {
+	tt = tt.In(t.Loc())
+	y, m, d := tt.Date()
+	return wallDate(y, m, d-(int(tt.Weekday()-weekday)+7)%7, 0, t.Loc())
+}
+
+// StartOfMonth gets the first instant of the month of tt in this zone.
+func (t *Zone) StartOfMonth(tt time.Time) time.Time {
+	y, m, _ := tt.In(t.Loc()).Date()
+	return wallDate(y, m, 1, 0, t.Loc())
+}
+
+// wallDate gets the time for the wall clock time since midnight on the date.
+//
+// This is like time.Date(), except that a wall clock time that doesn't exist
+// because it's skipped by a DST change (such as 02:30 on the day DST starts in
+// Europe, or midnight in some zones) is the time of the change, and a wall clock
+// time that exists twice because the clock is set back is the first occurrence,
+// rather than whatever time.Date() picks. This is the ShiftForward policy of
+// LocalTime().
+func wallDate(y int, m time.Month, d int, since time.Duration, loc *time.Location) time.Time {
+	earlier, later, s := wallTimes(y, m, d, since, loc)
+	t, _ := pick(earlier, later, s, ShiftForward)
+	return t
+}
+
+// sinceMidnight gets the wall clock time of tt since midnight.
+func sinceMidnight(tt time.Time) time.Duration {
+	h, m, s := tt.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(s)*time.Second + time.Duration(tt.Nanosecond())
+}
@@ -0,0 +1,108 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendar(t *testing.T) {
+	var (
+		ber = MustNew("", "Europe/Berlin")
+		san = MustNew("", "America/Santiago")
+		bei = MustNew("", "Asia/Beirut")
+		at  = func(z *Zone, s string) time.Time {
+			t, err := time.ParseInLocation("2006-01-02 15:04", s, z.Loc())
+			if err != nil {
+				panic(err)
+			}
+			return t
+		}
+		utc = func(s string) time.Time { return at(UTC, s) }
+	)
+
+	tests := []struct {
+		name string
+		have time.Time
+		want string
+	}{
+		// DST starts; the day is 23 hours.
+		{"add", ber.AddDate(at(ber, "2024-03-30 10:00"), 0, 0, 1), "2024-03-31 10:00:00 +0200"},
+		{"add", ber.AddDate(at(ber, "2024-03-31 10:00"), 0, 0, -1), "2024-03-30 10:00:00 +0100"},
+		{"add", ber.AddDate(at(ber, "2024-03-30 02:30"), 0, 0, 1), "2024-03-31 03:00:00 +0200"},
+		// DST ends; the day is 25 hours.
+		{"add", ber.AddDate(at(ber, "2024-10-26 10:00"), 0, 0, 1), "2024-10-27 10:00:00 +0100"},
+		{"add", ber.AddDate(at(ber, "2024-10-26 02:30"), 0, 0, 1), "2024-10-27 02:30:00 +0200"},
+		{"add", ber.AddDate(utc("2024-10-26 00:30"), 0, 0, 1), "2024-10-27 02:30:00 +0200"},
+		{"add", ber.AddDate(utc("2024-10-28 01:30"), 0, 0, -1), "2024-10-27 02:30:00 +0100"},
+		// Ambiguous, but the offset is kept.
+		{"add", ber.AddDate(utc("2024-10-27 00:30"), 0, 0, 0), "2024-10-27 02:30:00 +0200"},
+		{"add", ber.AddDate(utc("2024-10-27 01:30"), 0, 0, 0), "2024-10-27 02:30:00 +0100"},
+		{"add", ber.AddDate(at(ber, "2024-01-31 10:00"), 0, 1, 0), "2024-03-02 10:00:00 +0100"},
+		{"add", ber.AddDate(at(ber, "2024-01-31 10:00"), 1, 0, 0), "2025-01-31 10:00:00 +0100"},
+		{"add", ber.AddDate(at(ber, "2024-01-31 10:00"), 0, 0, 0), "2024-01-31 10:00:00 +0100"},
+
+		{"day", ber.StartOfDay(at(ber, "2024-03-31 18:00")), "2024-03-31 00:00:00 +0100"},
+		{"day", ber.EndOfDay(at(ber, "2024-03-31 18:00")), "2024-03-31 23:59:59.999999999 +0200"},
+		{"day", ber.StartOfDay(utc("2024-03-30 23:30")), "2024-03-31 00:00:00 +0100"},
+		{"day", ber.StartOfDay(utc("2024-03-30 22:30")), "2024-03-30 00:00:00 +0100"},
+		// Midnight doesn't exist.
+		{"day", san.StartOfDay(at(san, "2024-09-08 12:00")), "2024-09-08 01:00:00 -0300"},
+		{"day", san.EndOfDay(at(san, "2024-09-07 12:00")), "2024-09-07 23:59:59.999999999 -0400"},
+		{"day", bei.StartOfDay(at(bei, "2024-03-31 12:00")), "2024-03-31 01:00:00 +0300"},
+		{"day", bei.EndOfDay(at(bei, "2024-03-30 12:00")), "2024-03-30 23:59:59.999999999 +0200"},
+		// 23:00 to midnight exists twice.
+		{"day", san.StartOfDay(at(san, "2024-04-07 12:00")), "2024-04-07 00:00:00 -0400"},
+		{"day", san.EndOfDay(at(san, "2024-04-06 12:00")), "2024-04-06 23:59:59.999999999 -0400"},
+		{"add", san.AddDate(at(san, "2024-04-05 23:30"), 0, 0, 1), "2024-04-06 23:30:00 -0300"},
+
+		{"week", ber.StartOfWeek(at(ber, "2024-03-31 12:00"), time.Monday), "2024-03-25 00:00:00 +0100"},
+		{"week", ber.StartOfWeek(at(ber, "2024-03-31 12:00"), time.Sunday), "2024-03-31 00:00:00 +0100"},
+		{"week", ber.StartOfWeek(at(ber, "2024-04-01 12:00"), time.Sunday), "2024-03-31 00:00:00 +0100"},
+		{"week", ber.StartOfWeek(at(ber, "2024-04-01 12:00"), time.Saturday), "2024-03-30 00:00:00 +0100"},
+		{"week", san.StartOfWeek(at(san, "2024-09-10 12:00"), time.Sunday), "2024-09-08 01:00:00 -0300"},
+
+		{"month", ber.StartOfMonth(at(ber, "2024-03-31 12:00")), "2024-03-01 00:00:00 +0100"},
+		{"month", ber.StartOfMonth(utc("2024-03-31 22:30")), "2024-04-01 00:00:00 +0200"},
+		{"month", san.StartOfMonth(at(san, "2024-09-20 12:00")), "2024-09-01 00:00:00 -0400"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			have := tt.have.Format("2006-01-02 15:04:05.999999999 -0700")
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayLength(t *testing.T) {
+	tests := []struct {
+		zone, date string
+		want       time.Duration
+	}{
+		{"Europe/Berlin", "2024-03-31", 23 * time.Hour},
+		{"Europe/Berlin", "2024-10-27", 25 * time.Hour},
+		{"Europe/Berlin", "2024-07-01", 24 * time.Hour},
+		{"America/Santiago", "2024-09-08", 23 * time.Hour},
+		{"America/Santiago", "2024-04-06", 25 * time.Hour},
+		{"Australia/Lord_Howe", "2024-10-06", 23*time.Hour + 30*time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone+tt.date, func(t *testing.T) {
+			z := MustNew("", tt.zone)
+			d, err := time.ParseInLocation("2006-01-02 15:04", tt.date+" 12:00", z.Loc())
+			if err != nil {
+				t.Fatal(err)
+			}
+			have := z.EndOfDay(d).Add(1).Sub(z.StartOfDay(d))
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+			if next := z.StartOfDay(z.AddDate(d, 0, 0, 1)); !next.Equal(z.EndOfDay(d).Add(1)) {
+				t.Errorf("next day %s; end %s", next, z.EndOfDay(d))
+			}
+		})
+	}
+}
@@ -0,0 +1,570 @@
+//go:build go_run_only
+
+// Command extract writes the CLDR data that gen.go reads to the cldr directory:
+//
+//	windowsZones.xml   Mapping of Windows timezone IDs to zones.
+//	main/[lang].xml    Territory names and exemplar cities for every locale.
+//
+// The source is either a CLDR release, as a directory or the
+// cldr-common-[version].zip from https://cldr.unicode.org/index/downloads, or
+// the ICU data file (icudt[version]l.dat) that's built from a CLDR release. A
+// binary with the ICU data embedded in it, such as node, also works.
+//
+// For a CLDR release windowsZones.xml is copied as-is; everything else only
+// has the elements gen.go uses. Run it from the repo root, and then run
+// go generate:
+//
+//	go run -tags go_run_only ./cldr/extract.go ~/Downloads/cldr-common-47.0.zip
+//	go run -tags go_run_only ./cldr/extract.go /usr/bin/node
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// Languages to extract.
+var langs = []string{"de", "es", "fr", "it", "ja", "ko", "nl", "pl", "pt", "ru", "tr", "zh"}
+
+const license = `Copyright © 1991-2025 Unicode, Inc.
+For terms of use, see http://www.unicode.org/copyright.html
+SPDX-License-Identifier: Unicode-3.0
+CLDR data files are interpreted according to the LDML specification (http://unicode.org/reports/tr35/)`
+
+type (
+	territory struct{ Type, Alt, Name string }
+	city      struct{ Zone, Alt, Name string }
+	mapZone   struct{ Other, Territory, Type string }
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: extract [cldr-common.zip | cldr directory | icudt.dat]")
+		os.Exit(2)
+	}
+	src := os.Args[1]
+
+	var err error
+	if st, statErr := os.Stat(src); statErr == nil && st.IsDir() {
+		err = fromCLDR(os.DirFS(src), filepath.Base(filepath.Clean(src)))
+	} else if strings.HasSuffix(src, ".zip") {
+		var z *zip.ReadCloser
+		z, err = zip.OpenReader(src)
+		if err == nil {
+			defer z.Close()
+			err = fromCLDR(z, filepath.Base(src))
+		}
+	} else {
+		err = fromICU(src)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "extract:", err)
+		os.Exit(1)
+	}
+}
+
+// fromCLDR extracts the data from a CLDR release.
+func fromCLDR(fsys fs.FS, name string) error {
+	dtd, err := fs.ReadFile(fsys, "common/dtd/ldml.dtd")
+	if err != nil {
+		return err
+	}
+	m := regexp.MustCompile(`cldrVersion CDATA #FIXED "([^"]+)"`).FindSubmatch(dtd)
+	if m == nil {
+		return errors.New("common/dtd/ldml.dtd: no cldrVersion")
+	}
+	version := string(m[1])
+
+	w, err := fs.ReadFile(fsys, "common/supplemental/windowsZones.xml")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join("cldr", "windowsZones.xml"), w, 0o644)
+	if err != nil {
+		return err
+	}
+
+	for _, lang := range langs {
+		f, err := fs.ReadFile(fsys, "common/main/"+lang+".xml")
+		if err != nil {
+			return err
+		}
+		var ldml struct {
+			Territories []struct {
+				Type string `xml:"type,attr"`
+				Alt  string `xml:"alt,attr"`
+				Name string `xml:",chardata"`
+			} `xml:"localeDisplayNames>territories>territory"`
+			Zones []struct {
+				Type string `xml:"type,attr"`
+				City []struct {
+					Alt  string `xml:"alt,attr"`
+					Name string `xml:",chardata"`
+				} `xml:"exemplarCity"`
+			} `xml:"dates>timeZoneNames>zone"`
+		}
+		err = xml.Unmarshal(f, &ldml)
+		if err != nil {
+			return fmt.Errorf("%s: %w", lang, err)
+		}
+
+		var (
+			terr   []territory
+			cities []city
+		)
+		for _, t := range ldml.Territories {
+			if t.Name != "↑↑↑" { // Inherited from the parent locale.
+				terr = append(terr, territory{t.Type, t.Alt, t.Name})
+			}
+		}
+		for _, z := range ldml.Zones {
+			for _, c := range z.City {
+				if c.Name != "↑↑↑" {
+					cities = append(cities, city{z.Type, c.Alt, c.Name})
+				}
+			}
+		}
+		err = writeMain(lang, version, name, terr, cities)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fromICU extracts the data from the ICU data in the file.
+func fromICU(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d, err := readICU(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	ver, err := d.bundle("icuver")
+	if err != nil {
+		return err
+	}
+	icuVersion, _ := ver["ICUVersion"].(string)
+	cldrVersion, _ := ver["CLDRVersion"].(string)
+	icuVersion = strings.TrimSuffix(strings.TrimSuffix(icuVersion, ".0"), ".0")
+	from := fmt.Sprintf("the ICU %s data (%s)", icuVersion, d.prefix)
+
+	w, err := d.bundle("windowsZones")
+	if err != nil {
+		return err
+	}
+	mapTZ, _ := w["mapTimezones"].(map[string]any)
+	var zones []mapZone
+	for other, v := range mapTZ {
+		terr, _ := v.(map[string]any)
+		for t, z := range terr {
+			if z, ok := z.(string); ok {
+				zones = append(zones, mapZone{other, t, z})
+			}
+		}
+	}
+	sort.Slice(zones, func(i, j int) bool {
+		if zones[i].Other != zones[j].Other {
+			return zones[i].Other < zones[j].Other
+		}
+		if (zones[i].Territory == "001") != (zones[j].Territory == "001") {
+			return zones[i].Territory == "001"
+		}
+		return zones[i].Territory < zones[j].Territory
+	})
+	err = writeWindows(fmt.Sprintf(""+
+		"Generated by extract.go from %s, which is built from\n"+
+		"CLDR %s. This has the same mapZone entries as\n"+
+		"common/supplemental/windowsZones.xml, but without the upstream comments, and\n"+
+		"sorted by ID. Run extract.go with a CLDR release to use the upstream file\n"+
+		"instead.", from, cldrVersion), zones)
+	if err != nil {
+		return err
+	}
+
+	for _, lang := range langs {
+		region, err := d.bundle("region/" + lang)
+		if err != nil {
+			return err
+		}
+		var terr []territory
+		for k, v := range region {
+			key, alt, _ := strings.Cut(k, "%")
+			m, _ := v.(map[string]any)
+			if key != "Countries" {
+				continue
+			}
+			for t, name := range m {
+				if name, ok := name.(string); ok {
+					terr = append(terr, territory{t, alt, name})
+				}
+			}
+		}
+
+		zone, err := d.bundle("zone/" + lang)
+		if err != nil {
+			return err
+		}
+		var cities []city
+		zs, _ := zone["zoneStrings"].(map[string]any)
+		for k, v := range zs {
+			m, _ := v.(map[string]any)
+			if name, ok := m["ec"].(string); ok && !strings.HasPrefix(k, "meta:") {
+				cities = append(cities, city{strings.ReplaceAll(k, ":", "/"), "", name})
+			}
+		}
+
+		err = writeMain(lang, cldrVersion, from, terr, cities)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeWindows(comment string, zones []mapZone) error {
+	b := new(bytes.Buffer)
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\" ?>\n<!--\n" + license + "\n\n" + comment + "\n-->\n")
+	b.WriteString("<supplementalData>\n\t<windowsZones>\n\t\t<mapTimezones>\n")
+	for _, z := range zones {
+		fmt.Fprintf(b, "\t\t\t<mapZone other=%s territory=%s type=%s/>\n", attr(z.Other), attr(z.Territory), attr(z.Type))
+	}
+	b.WriteString("\t\t</mapTimezones>\n\t</windowsZones>\n</supplementalData>\n")
+	return os.WriteFile(filepath.Join("cldr", "windowsZones.xml"), b.Bytes(), 0o644)
+}
+
+func writeMain(lang, version, from string, terr []territory, cities []city) error {
+	sort.Slice(terr, func(i, j int) bool {
+		if terr[i].Type != terr[j].Type {
+			return terr[i].Type < terr[j].Type
+		}
+		return terr[i].Alt < terr[j].Alt
+	})
+	sort.Slice(cities, func(i, j int) bool {
+		if cities[i].Zone != cities[j].Zone {
+			return cities[i].Zone < cities[j].Zone
+		}
+		return cities[i].Alt < cities[j].Alt
+	})
+
+	b := new(bytes.Buffer)
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\" ?>\n<!--\n" + license + "\n\n")
+	fmt.Fprintf(b, "Subset of CLDR %s common/main/%s.xml with just the territory names and\n", version, lang)
+	fmt.Fprintf(b, "exemplar cities that gen.go uses. Generated by extract.go from\n%s.\n-->\n", from)
+	b.WriteString("<ldml>\n\t<identity>\n\t\t<version number=\"$Revision$\"/>\n")
+	fmt.Fprintf(b, "\t\t<language type=%s/>\n\t</identity>\n", attr(lang))
+	b.WriteString("\t<localeDisplayNames>\n\t\t<territories>\n")
+	for _, t := range terr {
+		fmt.Fprintf(b, "\t\t\t<territory type=%s%s>%s</territory>\n", attr(t.Type), alt(t.Alt), text(t.Name))
+	}
+	b.WriteString("\t\t</territories>\n\t</localeDisplayNames>\n\t<dates>\n\t\t<timeZoneNames>\n")
+	for i, c := range cities {
+		if i == 0 || cities[i-1].Zone != c.Zone {
+			fmt.Fprintf(b, "\t\t\t<zone type=%s>\n", attr(c.Zone))
+		}
+		fmt.Fprintf(b, "\t\t\t\t<exemplarCity%s>%s</exemplarCity>\n", alt(c.Alt), text(c.Name))
+		if i == len(cities)-1 || cities[i+1].Zone != c.Zone {
+			b.WriteString("\t\t\t</zone>\n")
+		}
+	}
+	b.WriteString("\t\t</timeZoneNames>\n\t</dates>\n</ldml>\n")
+
+	err := os.MkdirAll(filepath.Join("cldr", "main"), 0o755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join("cldr", "main", lang+".xml"), b.Bytes(), 0o644)
+}
+
+func text(s string) string {
+	b := new(strings.Builder)
+	xml.EscapeText(b, []byte(s))
+	return strings.NewReplacer("&#39;", "'", "&#34;", `"`).Replace(b.String())
+}
+
+func attr(s string) string { return `"` + strings.ReplaceAll(text(s), `"`, "&quot;") + `"` }
+
+func alt(s string) string {
+	if s == "" {
+		return ""
+	}
+	return " alt=" + attr(s)
+}
+
+// icuData is an ICU common data file with the resource bundles in it, in the
+// little-endian format.
+type icuData struct {
+	prefix string // icudt77l
+	items  map[string][]byte
+}
+
+func readICU(data []byte) (*icuData, error) {
+	// Find the header, in case the data is embedded in a binary: a 2-byte
+	// header size, the magic 0xda27, and the "CmnD" data format.
+	start := -1
+	for i := 0; ; {
+		n := bytes.Index(data[i:], []byte("CmnD"))
+		if n == -1 {
+			break
+		}
+		if s := i + n - 12; s >= 0 && data[s+2] == 0xda && data[s+3] == 0x27 {
+			start = s
+			break
+		}
+		i += n + 1
+	}
+	if start == -1 {
+		return nil, errors.New("no ICU data")
+	}
+	data = data[start:]
+
+	var (
+		toc   = int(binary.LittleEndian.Uint16(data))
+		count = int(binary.LittleEndian.Uint32(data[toc:]))
+		d     = &icuData{items: make(map[string][]byte, count)}
+		names = make([]string, count)
+		offs  = make([]int, count+1)
+	)
+	for i := 0; i < count; i++ {
+		nameOff := toc + int(binary.LittleEndian.Uint32(data[toc+4+i*8:]))
+		names[i] = string(data[nameOff : nameOff+bytes.IndexByte(data[nameOff:], 0)])
+		offs[i] = toc + int(binary.LittleEndian.Uint32(data[toc+8+i*8:]))
+	}
+	offs[count] = len(data)
+	for i, n := range names {
+		d.items[n] = data[offs[i]:offs[i+1]]
+		if d.prefix == "" {
+			d.prefix, _, _ = strings.Cut(n, "/")
+		}
+	}
+	return d, nil
+}
+
+// bundle reads the resource bundle, such as "zone/nl".
+func (d *icuData) bundle(name string) (map[string]any, error) {
+	data, ok := d.items[d.prefix+"/"+name+".res"]
+	if !ok {
+		return nil, fmt.Errorf("no resource bundle %q", name)
+	}
+	b, err := newBundle(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if b.usesPool {
+		dir, _ := filepath.Split(name)
+		pool, ok := d.items[d.prefix+"/"+dir+"pool.res"]
+		if !ok {
+			return nil, fmt.Errorf("%s: no pool bundle", name)
+		}
+		b.pool, err = newBundle(pool)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	m, ok := b.get(b.u32(0)).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: root is not a table", name)
+	}
+	return m, nil
+}
+
+// Resource types; see ICU's uresdata.h.
+const (
+	resString   = 0
+	resTable    = 2
+	resAlias    = 3
+	resTable32  = 4
+	resTable16  = 5
+	resStringV2 = 6
+	resInt      = 7
+	resArray    = 8
+	resArray16  = 9
+)
+
+// bundle is an ICU resource bundle (.res file), in format version 2 or 3.
+type bundle struct {
+	b                 []byte
+	pool              *bundle
+	usesPool          bool
+	keysBase          int // Byte offset of the keys.
+	localKeyLimit     int
+	p16               int // Byte offset of the 16-bit units.
+	poolStringLimit   int
+	poolString16Limit int
+}
+
+func newBundle(data []byte) (*bundle, error) {
+	if len(data) < 4 || data[2] != 0xda || data[3] != 0x27 {
+		return nil, errors.New("not a resource bundle")
+	}
+	b := &bundle{b: data[binary.LittleEndian.Uint16(data):]}
+
+	idx0 := b.u32(1)
+	indexes := make([]uint32, idx0&0xff)
+	for i := range indexes {
+		indexes[i] = b.u32(1 + i)
+	}
+	if len(indexes) < 6 {
+		return nil, errors.New("unsupported format version")
+	}
+	att := indexes[5]
+	b.keysBase = 4 * (1 + len(indexes))
+	b.localKeyLimit = int(indexes[1]) << 2
+	b.p16 = int(indexes[1]) * 4
+	b.usesPool = att&4 != 0
+	if len(indexes) > 7 {
+		b.poolStringLimit = int(idx0 >> 8)
+	}
+	b.poolStringLimit |= int(att&0xf000) << 12
+	b.poolString16Limit = int(att >> 16)
+	return b, nil
+}
+
+func (b *bundle) u32(n int) uint32 { return binary.LittleEndian.Uint32(b.b[n*4:]) }
+func (b *bundle) u16(off int) int  { return int(binary.LittleEndian.Uint16(b.b[off:])) }
+
+func (b *bundle) cstr(off int) string {
+	return string(b.b[off : off+bytes.IndexByte(b.b[off:], 0)])
+}
+
+// key gets the key at the offset; bundles that use a pool bundle and don't
+// have keys of their own refer to the pool's keys.
+func (b *bundle) key(k int) string {
+	switch {
+	case b.pool != nil && b.localKeyLimit <= b.keysBase:
+		return b.pool.cstr(b.pool.keysBase + k)
+	case k < b.localKeyLimit:
+		return b.cstr(k)
+	default:
+		return b.pool.cstr(b.pool.keysBase + k - b.localKeyLimit)
+	}
+}
+
+func (b *bundle) key32(k int32) string {
+	if k < 0 || b.pool != nil && b.localKeyLimit <= b.keysBase {
+		return b.pool.cstr(b.pool.keysBase + int(k&0x7fffffff))
+	}
+	return b.cstr(int(k))
+}
+
+// str16 reads a string of 16-bit units at the byte offset.
+func (b *bundle) str16(off int) string {
+	var (
+		first = b.u16(off)
+		units []uint16
+	)
+	switch {
+	case first&0xfc00 != 0xdc00: // NUL-terminated.
+		for o := off; b.u16(o) != 0; o += 2 {
+			units = append(units, uint16(b.u16(o)))
+		}
+		return string(utf16.Decode(units))
+	case first < 0xdfef:
+		return b.units(off+2, first&0x3ff)
+	case first < 0xdfff:
+		return b.units(off+4, (first-0xdfef)<<16|b.u16(off+2))
+	default:
+		return b.units(off+6, b.u16(off+2)<<16|b.u16(off+4))
+	}
+}
+
+func (b *bundle) units(off, n int) string {
+	units := make([]uint16, n)
+	for i := range units {
+		units[i] = uint16(b.u16(off + 2*i))
+	}
+	return string(utf16.Decode(units))
+}
+
+// from16 converts a 16-bit resource value to a string resource.
+func (b *bundle) from16(r int) uint32 {
+	if r >= b.poolString16Limit {
+		r = r - b.poolString16Limit + b.poolStringLimit
+	}
+	return resStringV2<<28 | uint32(r)
+}
+
+// get the resource as a string, map[string]any, []any, or nil for types that
+// aren't used here.
+func (b *bundle) get(res uint32) any {
+	off := int(res & 0x0fffffff)
+	switch res >> 28 {
+	case resStringV2:
+		if off < b.poolStringLimit {
+			return b.pool.str16(b.pool.p16 + 2*off)
+		}
+		return b.str16(b.p16 + 2*(off-b.poolStringLimit))
+	case resString:
+		if off == 0 {
+			return ""
+		}
+		return b.units((off+1)*4, int(b.u32(off)))
+	case resTable:
+		m := make(map[string]any)
+		if off == 0 {
+			return m
+		}
+		var (
+			base  = off * 4
+			n     = b.u16(base)
+			items = base + 2 + 2*n
+		)
+		if n&1 == 0 { // Padded to 32 bits.
+			items += 2
+		}
+		for i := 0; i < n; i++ {
+			m[b.key(b.u16(base+2+2*i))] = b.get(binary.LittleEndian.Uint32(b.b[items+4*i:]))
+		}
+		return m
+	case resTable16:
+		var (
+			m    = make(map[string]any)
+			base = b.p16 + 2*off
+			n    = b.u16(base)
+		)
+		for i := 0; i < n; i++ {
+			m[b.key(b.u16(base+2+2*i))] = b.get(b.from16(b.u16(base + 2 + 2*n + 2*i)))
+		}
+		return m
+	case resTable32:
+		m := make(map[string]any)
+		n := int(b.u32(off))
+		for i := 0; i < n; i++ {
+			m[b.key32(int32(b.u32(off+1+i)))] = b.get(b.u32(off + 1 + n + i))
+		}
+		return m
+	case resArray:
+		var a []any
+		if off == 0 {
+			return a
+		}
+		for i, n := 0, int(b.u32(off)); i < n; i++ {
+			a = append(a, b.get(b.u32(off+1+i)))
+		}
+		return a
+	case resArray16:
+		var (
+			a    []any
+			base = b.p16 + 2*off
+		)
+		for i, n := 0, b.u16(base); i < n; i++ {
+			a = append(a, b.get(b.from16(b.u16(base+2+2*i))))
+		}
+		return a
+	}
+	return nil // Int, alias, binary, etc.
+}
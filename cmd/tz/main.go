@@ -0,0 +1,280 @@
+// Command tz shows information about timezones.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"zgo.at/tz"
+	_ "zgo.at/tz/tzembed"
+)
+
+const usage = `Usage: tz <command> [arguments]
+
+Commands:
+
+  list [--country NL]                  List all zones, or all zones for a country.
+  lookup [--country NL] <zone>         Show how a zone name is resolved and its aliases.
+  now <zone...>                        Show the current time in every zone.
+  convert <time> --from zone --to zone Convert a time between zones; the time is
+                                       "2006-01-02 15:04", "2006-01-02 15:04:05",
+                                       "15:04" (today), or RFC 3339. Prints a
+                                       warning if the time is ambiguous or
+                                       doesn't exist in the --from zone.
+  search [-n 10] <query>               Search zones by name, city, country, or
+                                       abbreviation.
+
+Zones are IANA zone names (Europe/Amsterdam), with an optional country code
+(NL.Europe/Amsterdam), aliases (Asia/Saigon), or Windows IDs (W. Europe
+Standard Time).
+`
+
+var now = time.Now
+
+func main() {
+	err := run(os.Args[1:], os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "tz:", err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprint(os.Stderr, "\n", usage)
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+var errUsage = errors.New("invalid usage")
+
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: need a command", errUsage)
+	}
+
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "list":
+		return list(args, out)
+	case "lookup":
+		return lookup(args, out)
+	case "now":
+		return nowCmd(args, out)
+	case "convert":
+		return convert(args, out)
+	case "search":
+		return search(args, out)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(out, usage)
+		return nil
+	}
+	return fmt.Errorf("%w: unknown command %q", errUsage, cmd)
+}
+
+// parse the flags, allowing flags after positional arguments ("convert
+// 09:00 --from X"), which the flag package doesn't do.
+func parse(f *flag.FlagSet, args []string) ([]string, error) {
+	f.SetOutput(io.Discard)
+	var pos []string
+	for {
+		err := f.Parse(args)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errUsage, err)
+		}
+		args = f.Args()
+		if len(args) == 0 {
+			return pos, nil
+		}
+		pos, args = append(pos, args[0]), args[1:]
+	}
+}
+
+// zone gets a zone from "Europe/Amsterdam" or "NL.Europe/Amsterdam".
+func zone(s string) (*tz.Zone, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: need a zone", errUsage)
+	}
+	var z tz.Zone
+	err := z.Scan(s)
+	if errors.Is(err, tz.ErrInvalidFormat) {
+		return tz.New("", s)
+	}
+	return &z, err
+}
+
+func list(args []string, out io.Writer) error {
+	f := flag.NewFlagSet("list", flag.ContinueOnError)
+	ccode := f.String("country", "", "")
+	pos, err := parse(f, args)
+	if err != nil {
+		return err
+	}
+	if len(pos) > 0 {
+		return fmt.Errorf("%w: list doesn't accept arguments", errUsage)
+	}
+
+	zones := tz.Zones
+	if *ccode != "" {
+		zones = tz.ByCountry(strings.ToUpper(*ccode))
+		if len(zones) == 0 {
+			return fmt.Errorf("no zones for country %q", *ccode)
+		}
+	}
+	for _, z := range zones {
+		fmt.Fprintln(out, z.Display())
+	}
+	return nil
+}
+
+func lookup(args []string, out io.Writer) error {
+	f := flag.NewFlagSet("lookup", flag.ContinueOnError)
+	ccode := f.String("country", "", "")
+	pos, err := parse(f, args)
+	if err != nil {
+		return err
+	}
+	if len(pos) == 0 {
+		return fmt.Errorf("%w: need a zone", errUsage)
+	}
+
+	z, r, err := tz.Resolve(strings.ToUpper(*ccode), strings.Join(pos, " "))
+	if err != nil {
+		return err
+	}
+
+	t := now()
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Zone:\t%s\n", z)
+	fmt.Fprintf(w, "Display:\t%s\n", z.Display())
+	fmt.Fprintf(w, "Resolved:\t%s\n", r.Method)
+	fmt.Fprintf(w, "Offset:\t%s (%s)\n", z.OffsetDisplayAt(t), z.AbbrAt(t))
+	if a := z.Aliases(); len(a) > 0 {
+		fmt.Fprintf(w, "Aliases:\t%s\n", strings.Join(a, ", "))
+	}
+	if id := z.WindowsID(); id != "" {
+		fmt.Fprintf(w, "Windows ID:\t%s\n", id)
+	}
+	if z.Latitude != 0 || z.Longitude != 0 {
+		fmt.Fprintf(w, "Coordinates:\t%.4f, %.4f\n", z.Latitude, z.Longitude)
+	}
+	return w.Flush()
+}
+
+func nowCmd(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: need at least one zone", errUsage)
+	}
+
+	t := now()
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, a := range args {
+		z, err := zone(a)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", a, t.In(z.Loc()).Format("2006-01-02 15:04:05 MST"), z.OffsetDisplayAt(t))
+	}
+	return w.Flush()
+}
+
+// Layouts accepted by convert.
+var layouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+	"15:04",
+}
+
+func convert(args []string, out io.Writer) error {
+	f := flag.NewFlagSet("convert", flag.ContinueOnError)
+	from := f.String("from", "UTC", "")
+	to := f.String("to", "", "")
+	pos, err := parse(f, args)
+	if err != nil {
+		return err
+	}
+	if len(pos) != 1 {
+		return fmt.Errorf("%w: need exactly one time", errUsage)
+	}
+	fromZ, err := zone(*from)
+	if err != nil {
+		return err
+	}
+	toZ, err := zone(*to)
+	if err != nil {
+		return err
+	}
+
+	t, warn, err := parseTime(pos[0], fromZ)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\t%s\t%s\n", *from, t.In(fromZ.Loc()).Format("2006-01-02 15:04:05 MST"), fromZ.OffsetDisplayAt(t))
+	fmt.Fprintf(w, "%s\t%s\t%s\n", *to, t.In(toZ.Loc()).Format("2006-01-02 15:04:05 MST"), toZ.OffsetDisplayAt(t))
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if warn != nil {
+		_, err = fmt.Fprintf(out, "\nwarning: %s; using %s\n", warn, t.In(fromZ.Loc()).Format("15:04:05 -07:00"))
+	}
+	return err
+}
+
+// parseTime parses the time as a wall clock time in the zone. Times that are
+// ambiguous or don't exist because of a DST change are resolved with
+// tz.ShiftForward, and the *tz.LocalTimeError is returned as a warning.
+func parseTime(s string, in *tz.Zone) (time.Time, *tz.LocalTimeError, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil, nil
+	}
+	for _, l := range layouts {
+		wall, err := time.Parse(l, s)
+		if err != nil {
+			continue
+		}
+		if l == "15:04" {
+			y, m, d := now().In(in.Loc()).Date()
+			wall = time.Date(y, m, d, wall.Hour(), wall.Minute(), 0, 0, time.UTC)
+		}
+
+		var (
+			y, m, d     = wall.Date()
+			h, min, sec = wall.Clock()
+		)
+		t, _, err := in.LocalTime(y, m, d, h, min, sec, tz.Reject)
+		if err == nil {
+			return t, nil, nil
+		}
+		t, _, _ = in.LocalTime(y, m, d, h, min, sec, tz.ShiftForward)
+		return t, err.(*tz.LocalTimeError), nil
+	}
+	return time.Time{}, nil, fmt.Errorf("can't parse time %q", s)
+}
+
+func search(args []string, out io.Writer) error {
+	f := flag.NewFlagSet("search", flag.ContinueOnError)
+	n := f.Int("n", 10, "")
+	pos, err := parse(f, args)
+	if err != nil {
+		return err
+	}
+	if len(pos) == 0 {
+		return fmt.Errorf("%w: need a query", errUsage)
+	}
+
+	matches := tz.Search(strings.Join(pos, " "), *n)
+	if len(matches) == 0 {
+		return errors.New("no matches")
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, m := range matches {
+		fmt.Fprintf(w, "%s\t%s\t%s: %s\n", m.Zone, m.Zone.OffsetDisplayAt(now()), m.Field, m.Text)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	now = func() time.Time { return time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{[]string{"list", "--country", "id"}, `
+			Indonesia: Asia/Jakarta (WIB) – Java, Sumatra
+			Indonesia: Asia/Jayapura (WIT) – New Guinea (West Papua / Irian Jaya), Malukus/Moluccas
+			Indonesia: Asia/Makassar (WITA) – Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)
+			Indonesia: Asia/Pontianak (WIB) – Borneo (west, central)`, ""},
+		{[]string{"lookup", "Asia/Saigon"}, `
+			Zone:         VN.Asia/Ho_Chi_Minh
+			Display:      Vietnam: Asia/Ho_Chi_Minh – south Vietnam
+			Resolved:     alias
+			Offset:       UTC +7:00 (+07)
+			Aliases:      Asia/Saigon
+			Windows ID:   SE Asia Standard Time
+			Coordinates:  10.7500, 106.6667`, ""},
+		{[]string{"now", "Asia/Makassar", "NL.Europe/Brussels", "UTC"}, `
+			Asia/Makassar       2024-07-01 20:00:00 WITA  UTC +8:00
+			NL.Europe/Brussels  2024-07-01 14:00:00 CEST  UTC +2:00
+			UTC                 2024-07-01 12:00:00 UTC   UTC`, ""},
+		{[]string{"convert", "2024-01-15 09:00", "--from", "Europe/Berlin", "--to", "Asia/Makassar"}, `
+			Europe/Berlin  2024-01-15 09:00:00 CET   UTC +1:00
+			Asia/Makassar  2024-01-15 16:00:00 WITA  UTC +8:00`, ""},
+		{[]string{"convert", "--to", "Asia/Makassar", "09:00"}, `
+			UTC            2024-07-01 09:00:00 UTC   UTC
+			Asia/Makassar  2024-07-01 17:00:00 WITA  UTC +8:00`, ""},
+		{[]string{"convert", "2024-10-27 02:30", "--from", "Europe/Amsterdam", "--to", "UTC"}, `
+			Europe/Amsterdam  2024-10-27 02:30:00 CEST  UTC +2:00
+			UTC               2024-10-27 00:30:00 UTC   UTC
+
+			warning: ambiguous local time: 2024-10-27 02:30:00 in Europe/Brussels: 02:30:00 +02:00 or 02:30:00 +01:00; using 02:30:00 +02:00`, ""},
+		{[]string{"convert", "2024-03-31 02:30", "--from", "Europe/Amsterdam", "--to", "UTC"}, `
+			Europe/Amsterdam  2024-03-31 03:00:00 CEST  UTC +2:00
+			UTC               2024-03-31 01:00:00 UTC   UTC
+
+			warning: nonexistent local time: 2024-03-31 02:30:00 in Europe/Brussels: 01:30:00 +01:00 or 03:30:00 +02:00; using 03:00:00 +02:00`, ""},
+		{[]string{"search", "-n", "1", "makassar"}, `
+			ID.Asia/Makassar  UTC +8:00  city: Makassar`, ""},
+
+		{nil, "", "invalid usage: need a command"},
+		{[]string{"xxx"}, "", `invalid usage: unknown command "xxx"`},
+		{[]string{"lookup", "Nowhere/Nothing"}, "", "unknown timezone"},
+		{[]string{"convert", "09:00"}, "", "invalid usage: need a zone"},
+		{[]string{"convert", "yesterday", "--to", "UTC"}, "", `can't parse time "yesterday"`},
+		{[]string{"list", "--country", "XX"}, "", `no zones for country "XX"`},
+		{[]string{"list", "--foo"}, "", "invalid usage: flag provided but not defined: -foo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			out := new(strings.Builder)
+			err := run(tt.args, out)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("wrong error\nhave: %v\nwant: %s", err, tt.wantErr)
+			}
+			if tt.wantErr != "" && !errors.Is(err, errUsage) && strings.HasPrefix(tt.wantErr, "invalid usage") {
+				t.Errorf("not errUsage: %v", err)
+			}
+
+			have := strings.TrimSpace(out.String())
+			want := strings.TrimSpace(strings.ReplaceAll(tt.want, "\t", ""))
+			if have != want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, want)
+			}
+		})
+	}
+}
+
+func errorContains(have error, want string) bool {
+	if have == nil {
+		return want == ""
+	}
+	if want == "" {
+		return false
+	}
+	return strings.Contains(have.Error(), want)
+}
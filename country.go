@@ -0,0 +1,51 @@
+package tz
+
+import (
+	"sort"
+)
+
+// Country represents a country, with all the zones in that country.
+type Country struct {
+	Code  string  // ID
+	Name  string  // Indonesia
+	Zones []*Zone // Asia/Jakarta, Asia/Jayapura, Asia/Makassar, Asia/Pontianak
+}
+
+// Countries gets a list of all countries, sorted by country code.
+//
+// Not all countries have zones; for example Bouvet Island is uninhabited and
+// has no zones. The returned slice is shared and should not be modified.
+func Countries() []*Country {
+	loadLocations()
+	return countries
+}
+
+// CountryByCode gets the country by the ISO 3166 country code, or nil if there
+// is no such country.
+func CountryByCode(code string) *Country {
+	loadLocations()
+	i := sort.Search(len(countries), func(i int) bool { return countries[i].Code >= code })
+	if i < len(countries) && countries[i].Code == code {
+		return countries[i]
+	}
+	return nil
+}
+
+// DefaultZone gets the default zone for this country; this is the same zone
+// New() uses if only the country code is given.
+//
+// Returns nil if the country has no zones.
+func (c *Country) DefaultZone() *Zone {
+	if c == nil || len(c.Zones) == 0 {
+		return nil
+	}
+	return c.Zones[0]
+}
+
+// String gets the country code.
+func (c *Country) String() string {
+	if c == nil {
+		return ""
+	}
+	return c.Code
+}
@@ -0,0 +1,62 @@
+package tz
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCountry(t *testing.T) {
+	tests := []struct {
+		in          string
+		wantName    string
+		wantZones   string
+		wantDefault string
+	}{
+		{"ID", "Indonesia", "[ID.Asia/Jakarta ID.Asia/Jayapura ID.Asia/Makassar ID.Asia/Pontianak]", "ID.Asia/Jakarta"},
+		{"NL", "Netherlands", "[NL.Europe/Brussels]", "NL.Europe/Brussels"},
+		{"BV", "Bouvet Island", "[]", ""},
+		{"XX", "", "[]", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			c := CountryByCode(tt.in)
+			if tt.wantName == "" {
+				if c != nil {
+					t.Fatalf("not nil: %#v", c)
+				}
+				return
+			}
+
+			if c.Name != tt.wantName {
+				t.Errorf("\nhave: %s\nwant: %s", c.Name, tt.wantName)
+			}
+			if have := fmt.Sprint(c.Zones); have != tt.wantZones {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.wantZones)
+			}
+			if have := c.DefaultZone().String(); have != tt.wantDefault {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.wantDefault)
+			}
+			if z, _ := New(tt.in, ""); z.String() != tt.wantDefault {
+				t.Errorf("New() doesn't match DefaultZone(): %s", z)
+			}
+		})
+	}
+}
+
+func TestCountries(t *testing.T) {
+	c := Countries()
+	if len(c) < 240 {
+		t.Errorf("len is %d", len(c))
+	}
+	for i := range c {
+		if i > 0 && c[i-1].Code >= c[i].Code {
+			t.Errorf("not sorted: %s, %s", c[i-1], c[i])
+		}
+	}
+	for _, z := range Zones {
+		if CountryByCode(z.CountryCode) == nil {
+			t.Errorf("no country for %s", z)
+		}
+	}
+}
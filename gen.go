@@ -3,24 +3,44 @@
 package main
 
 import (
+	"bytes"
+	"encoding/xml"
 	"fmt"
+	"go/format"
+	"math"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// Directory to read the tzdata from; can be set with TZDIR, like zdump.
+var tzdir = "/usr/share/zoneinfo"
+
+func init() {
+	if d := os.Getenv("TZDIR"); d != "" {
+		tzdir = d
+		if os.Getenv("ZONEINFO") == "" { // For time.LoadLocation()
+			os.Setenv("ZONEINFO", d)
+		}
+	}
+}
+
 type Zone struct {
 	CountryCode string   // ID
 	Zone        string   // Asia/Makassar
 	Abbr        []string // WITA
 	CountryName string   // Indonesia
 	Comments    string   // Borneo (east, south); Sulawesi/Celebes, Bali, Nusa Tengarra; Timor (west)
+	Latitude    float64  // -5.1167
+	Longitude   float64  // 119.4
 }
 
 func readISO() map[string]string {
-	f, err := os.ReadFile("/usr/share/zoneinfo/iso3166.tab")
+	f, err := os.ReadFile(filepath.Join(tzdir, "iso3166.tab"))
 	if err != nil {
 		panic(err)
 	}
@@ -82,6 +102,226 @@ func readAbbr(names []string) map[string][]string {
 	return r
 }
 
+// readAliases reads the Link lines from tzdata.zi.
+//
+// tzdata may be compiled with the "backzone" data, in which case zones that are
+// identical since 1970 are listed as a Zone instead of a Link. Map these to the
+// zone in zone1970.tab with the same transitions, preferring zones in the same
+// country as listed in zone.tab.
+func readAliases(zones1970 map[string][]string) map[string]string {
+	f, err := os.ReadFile(filepath.Join(tzdir, "tzdata.zi"))
+	if err != nil {
+		panic(err)
+	}
+
+	var (
+		aliases = make(map[string]string)
+		zones   []string
+	)
+	for _, line := range strings.Split(string(f), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			continue
+		}
+		switch f[0] {
+		case "L", "Link":
+			aliases[f[2]] = f[1]
+		case "Z", "Zone":
+			zones = append(zones, f[1])
+		}
+	}
+
+	ztab := readZoneTab()
+	sorted := make([]string, 0, len(zones1970))
+	for z := range zones1970 {
+		sorted = append(sorted, z)
+	}
+	sort.Strings(sorted)
+	for _, z := range zones {
+		if _, ok := zones1970[z]; ok || strings.HasPrefix(z, "Etc/") || z == "Factory" {
+			continue
+		}
+		if m := matchZone(z, ztab[z], sorted, zones1970); m != "" {
+			aliases[z] = m
+		} else if m, ok := legacy[z]; ok {
+			aliases[z] = m
+		} else {
+			fmt.Fprintf(os.Stderr, "warning: no zone in zone1970.tab matches %q; not adding alias\n", z)
+		}
+	}
+
+	// Resolve links to links.
+	for k, v := range aliases {
+		for i := 0; i < 10; i++ {
+			a, ok := aliases[v]
+			if !ok {
+				break
+			}
+			v = a
+		}
+		aliases[k] = v
+	}
+
+	// Make sure New() can do something with all aliases.
+	var bad []string
+	for k, v := range aliases {
+		if _, ok := zones1970[v]; !ok && !validEtc(v) {
+			bad = append(bad, fmt.Sprintf("%q → %q", k, v))
+		}
+	}
+	if len(bad) > 0 {
+		sort.Strings(bad)
+		fmt.Fprintf(os.Stderr, "alias target not in zone1970.tab or Etc/:\n\t%s\n", strings.Join(bad, "\n\t"))
+		os.Exit(1)
+	}
+	return aliases
+}
+
+// Legacy zones that are a Link since tzdata 2024b, but which don't match their
+// target since 1970.
+var legacy = map[string]string{
+	"EET": "Europe/Athens",
+	"MET": "Europe/Brussels",
+	"WET": "Europe/Lisbon",
+}
+
+// validEtc reports if zone is handled by the Etc/ logic in New().
+func validEtc(zone string) bool {
+	zone, ok := strings.CutPrefix(zone, "Etc/")
+	if !ok {
+		return false
+	}
+	if zone == "UTC" || zone == "GMT" || zone == "Unknown" {
+		return true
+	}
+	zone, ok = strings.CutPrefix(zone, "GMT")
+	if !ok {
+		return false
+	}
+	_, err := strconv.ParseInt(zone, 10, 8)
+	return err == nil
+}
+
+// readZoneTab reads the country for every zone in zone.tab.
+func readZoneTab() map[string]string {
+	f, err := os.ReadFile(filepath.Join(tzdir, "zone.tab"))
+	if err != nil {
+		panic(err)
+	}
+
+	r := make(map[string]string)
+	for _, line := range strings.Split(string(f), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = line[:p]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		// #codes	coordinates	TZ	comments
+		s := strings.Split(line, "\t")
+		r[s[2]] = s[0]
+	}
+	return r
+}
+
+// matchZone finds the zone from zone1970.tab which has the same offsets and
+// abbreviations as zone since 1970.
+func matchZone(zone, country string, sorted []string, zones1970 map[string][]string) string {
+	want := history(zone)
+
+	var match string
+	for _, z := range sorted {
+		if history(z) != want {
+			continue
+		}
+		if country == "" {
+			return z
+		}
+		for _, c := range zones1970[z] {
+			if c == country {
+				return z
+			}
+		}
+		if match == "" {
+			match = z
+		}
+	}
+	return match
+}
+
+var histories = make(map[string]string)
+
+// history gets all offsets and abbreviations for the zone since 1970, as a
+// string that can be compared.
+func history(zone string) string {
+	if h, ok := histories[zone]; ok {
+		return h
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		panic(err)
+	}
+	var (
+		b        strings.Builder
+		t        = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+		end      = time.Now().AddDate(20, 0, 0)
+		prevName string
+		prevOff  = -1
+	)
+	for t.Before(end) {
+		lt := t.In(loc)
+		// Transitions that don't change anything are skipped, as that's just
+		// an artifact of how it's defined in tzdata.
+		if name, off := lt.Zone(); name != prevName || off != prevOff {
+			fmt.Fprintf(&b, "%d %s %d\n", t.Unix(), name, off)
+			prevName, prevOff = name, off
+		}
+
+		_, next := lt.ZoneBounds()
+		if !next.After(t) {
+			break
+		}
+		t = next
+	}
+	histories[zone] = b.String()
+	return histories[zone]
+}
+
+// parseCoord parses ISO 6709 coordinates as used in zone1970.tab, which are
+// either ±DDMM±DDDMM or ±DDMMSS±DDDMMSS.
+func parseCoord(s string) (lat, lon float64) {
+	i := strings.LastIndexAny(s, "+-")
+	if i < 1 {
+		panic(fmt.Sprintf("parseCoord: invalid coordinates: %q", s))
+	}
+	return parseDeg(s[:i], 2), parseDeg(s[i:], 3)
+}
+
+// parseDeg parses ±DDMM[SS], where the number of D's is given in n.
+func parseDeg(s string, n int) float64 {
+	if len(s) != 1+n+2 && len(s) != 1+n+4 {
+		panic(fmt.Sprintf("parseDeg: invalid coordinates: %q", s))
+	}
+	atoi := func(s string) float64 {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			panic(err)
+		}
+		return float64(i)
+	}
+
+	d := atoi(s[1:1+n]) + atoi(s[1+n:3+n])/60
+	if len(s) > 3+n {
+		d += atoi(s[3+n:]) / 3600
+	}
+	if s[0] == '-' {
+		d = -d
+	}
+	return math.Round(d*1e4) / 1e4
+}
+
 // Uniq removes duplicate entries from list; the list will be sorted.
 func Uniq(list []string) []string {
 	sort.Strings(list)
@@ -99,14 +339,15 @@ func Uniq(list []string) []string {
 func main() {
 	iso := readISO()
 
-	f, err := os.ReadFile("/usr/share/zoneinfo/zone1970.tab")
+	f, err := os.ReadFile(filepath.Join(tzdir, "zone1970.tab"))
 	if err != nil {
 		panic(err)
 	}
 
 	var (
-		r     []Zone
-		names []string
+		r         []Zone
+		names     []string
+		zones1970 = make(map[string][]string)
 	)
 	for _, line := range strings.Split(string(f), "\n") {
 		if p := strings.Index(line, "#"); p > -1 {
@@ -125,13 +366,17 @@ func main() {
 			desc = s[3]
 		}
 
+		lat, lon := parseCoord(s[1])
 		names = append(names, s[2])
+		zones1970[s[2]] = countries
 		for _, country := range countries {
 			r = append(r, Zone{
 				CountryCode: country,
 				CountryName: iso[country],
 				Zone:        s[2],
 				Comments:    desc,
+				Latitude:    lat,
+				Longitude:   lon,
 			})
 		}
 	}
@@ -145,12 +390,311 @@ func main() {
 		}
 	}
 
-	fmt.Print("package tz\n\n")
-	fmt.Println("// Zones is a list of all timezones by country.")
-	fmt.Println("var Zones = []*Zone{")
+	list := new(bytes.Buffer)
+	list.WriteString("package tz\n\n")
+	list.WriteString("// Zones is a list of all timezones by country.\n")
+	list.WriteString("var Zones = []*Zone{\n")
 	for i := range r {
 		l := fmt.Sprintf("%#v,\n", r[i])
-		fmt.Print("\t" + l[9:])
+		list.WriteString("\t" + l[9:])
+	}
+	list.WriteString("}\n\n")
+
+	codes := sortedKeys(iso)
+	list.WriteString("// countries is a list of all countries in iso3166.tab; this includes\n")
+	list.WriteString("// countries without any zones.\n")
+	list.WriteString("var countries = []*Country{\n")
+	for _, c := range codes {
+		fmt.Fprintf(list, "\t{Code: %q, Name: %q},\n", c, iso[c])
+	}
+	list.WriteString("}\n")
+	write("list.go", list.Bytes())
+
+	aliases := readAliases(zones1970)
+	keys := sortedKeys(aliases)
+
+	alias := new(bytes.Buffer)
+	alias.WriteString("package tz\n\n")
+	alias.WriteString("// aliases maps zone names that aren't in Zones to a zone that is, or to an\n")
+	alias.WriteString("// Etc/ zone. Generated by gen.go from the Link lines in tzdata.zi.\n")
+	alias.WriteString("var aliases = map[string]string{\n")
+	for _, k := range keys {
+		fmt.Fprintf(alias, "\t%q: %q,\n", k, aliases[k])
+	}
+	alias.WriteString("}\n\n")
+
+	ztab := readZoneTab()
+	alias.WriteString("// aliasCountries maps aliases to the country they're listed for in zone.tab.\n")
+	alias.WriteString("var aliasCountries = map[string]string{\n")
+	for _, k := range keys {
+		if c, ok := ztab[k]; ok {
+			fmt.Fprintf(alias, "\t%q: %q,\n", k, c)
+		}
+	}
+	alias.WriteString("}\n")
+	write("alias.go", alias.Bytes())
+
+	writeWindows(zones1970, aliases)
+
+	locales, err := filepath.Glob(filepath.Join("cldr", "main", "*.xml"))
+	if err != nil {
+		panic(err)
+	}
+	for _, l := range locales {
+		writeLocale(strings.TrimSuffix(filepath.Base(l), ".xml"), iso, zones1970, aliases, ztab)
+	}
+}
+
+// writeLocale writes the locale/[lang] package from the CLDR XML data in
+// cldr/main/[lang].xml, which is written by cldr/extract.go.
+//
+// CLDR only lists the exemplar city if it's different from the zone name, and
+// may use old zone names (Asia/Calcutta); these are resolved with the aliases.
+// Aliases for a different location in zone.tab are skipped, as the city for
+// Europe/Copenhagen isn't the city for Europe/Berlin.
+func writeLocale(lang string, iso map[string]string, zones1970 map[string][]string, aliases, ztab map[string]string) {
+	f, err := os.ReadFile(filepath.Join("cldr", "main", lang+".xml"))
+	if err != nil {
+		panic(err)
+	}
+
+	var ldml struct {
+		Territories []struct {
+			Type string `xml:"type,attr"`
+			Alt  string `xml:"alt,attr"`
+			Name string `xml:",chardata"`
+		} `xml:"localeDisplayNames>territories>territory"`
+		Zones []struct {
+			Type string `xml:"type,attr"`
+			City []struct {
+				Alt  string `xml:"alt,attr"`
+				Name string `xml:",chardata"`
+			} `xml:"exemplarCity"`
+		} `xml:"dates>timeZoneNames>zone"`
+	}
+	err = xml.Unmarshal(f, &ldml)
+	if err != nil {
+		panic(fmt.Errorf("%s: %w", lang, err))
+	}
+
+	countries := make(map[string]string)
+	for _, t := range ldml.Territories {
+		if _, ok := iso[t.Type]; ok && t.Alt == "" {
+			countries[t.Type] = t.Name
+		}
+	}
+	cities := make(map[string]string)
+	for _, z := range ldml.Zones {
+		zone := z.Type
+		if a, ok := aliases[zone]; ok {
+			if _, ok := ztab[zone]; ok {
+				continue
+			}
+			zone = a
+		}
+		if _, ok := zones1970[zone]; !ok {
+			continue
+		}
+		for _, c := range z.City {
+			if c.Alt == "" {
+				cities[zone] = c.Name
+			}
+		}
+	}
+
+	var (
+		tag = strings.ReplaceAll(lang, "_", "-")
+		pkg = strings.ToLower(strings.ReplaceAll(lang, "_", ""))
+		b   = new(bytes.Buffer)
+	)
+	fmt.Fprintf(b, "// Package %s registers the %s locale for zgo.at/tz, generated from CLDR.\n", pkg, tag)
+	fmt.Fprintf(b, "package %s\n\n", pkg)
+	b.WriteString("import \"zgo.at/tz\"\n\n")
+	b.WriteString("func init() {\n")
+	fmt.Fprintf(b, "\ttz.RegisterLocale(&tz.Locale{\n\t\tLang: %q,\n", tag)
+	b.WriteString("\t\tCountries: map[string]string{\n")
+	for _, k := range sortedKeys(countries) {
+		fmt.Fprintf(b, "\t\t\t%q: %q,\n", k, countries[k])
+	}
+	b.WriteString("\t\t},\n\t\tCities: map[string]string{\n")
+	for _, k := range sortedKeys(cities) {
+		fmt.Fprintf(b, "\t\t\t%q: %q,\n", k, cities[k])
+	}
+	b.WriteString("\t\t},\n\t})\n}\n")
+
+	dir := filepath.Join("locale", tag)
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		panic(err)
+	}
+	write(filepath.Join(dir, pkg+".go"), b.Bytes())
+}
+
+// writeWindows writes windows.go from the CLDR mapping of Windows timezone IDs
+// in cldr/windowsZones.xml, which is written by cldr/extract.go.
+//
+// CLDR uses the old zone names (Asia/Calcutta), and may map to Etc/ zones for
+// some territories; these are resolved with the aliases.
+func writeWindows(zones1970 map[string][]string, aliases map[string]string) {
+	f, err := os.ReadFile(filepath.Join("cldr", "windowsZones.xml"))
+	if err != nil {
+		panic(err)
+	}
+
+	var data struct {
+		Zones []struct {
+			Other     string `xml:"other,attr"`
+			Territory string `xml:"territory,attr"`
+			Type      string `xml:"type,attr"`
+		} `xml:"windowsZones>mapTimezones>mapZone"`
+	}
+	err = xml.Unmarshal(f, &data)
+	if err != nil {
+		panic(err)
+	}
+
+	var (
+		windows  = make(map[string]map[string]string)
+		reverse  = make(map[string]string)
+		rank     = make(map[string]int)
+		byTerr   = make(map[string]map[string]string) // Territory → zone → ID
+		terrRank = make(map[string]map[string]int)
+	)
+	for _, z := range data.Zones {
+		var (
+			zones []string
+			ranks []int
+		)
+		for _, zone := range strings.Fields(z.Type) {
+			// Prefer the default mapping for the reverse lookup, and then a
+			// mapping listed under the zone's own name rather than an alias
+			// (Europe/Brussels is "Romance Standard Time" for BE, not "W.
+			// Europe Standard Time" for NL as Europe/Amsterdam).
+			r := 0
+			if z.Territory != "001" {
+				r = 2
+			}
+			if a, ok := aliases[zone]; ok {
+				zone, r = a, r+1
+			}
+			if zone == "Etc/UTC" {
+				zone = "UTC"
+			}
+			if _, ok := zones1970[zone]; !ok && zone != "UTC" && !validEtc(zone) {
+				panic(fmt.Sprintf("windowsZones.xml: %q for %q %q: not in zone1970.tab", zone, z.Other, z.Territory))
+			}
+			zones, ranks = append(zones, zone), append(ranks, r)
+		}
+		if len(zones) == 0 {
+			continue
+		}
+
+		if windows[z.Other] == nil {
+			windows[z.Other] = make(map[string]string)
+		}
+		windows[z.Other][z.Territory] = zones[0]
+
+		for i, zone := range zones {
+			if have, ok := rank[zone]; !ok || ranks[i] < have {
+				reverse[zone], rank[zone] = z.Other, ranks[i]
+			}
+		}
+
+		// For the territory, prefer the zone that FromWindows() returns.
+		if z.Territory == "001" || z.Territory == "ZZ" {
+			continue
+		}
+		if byTerr[z.Territory] == nil {
+			byTerr[z.Territory], terrRank[z.Territory] = make(map[string]string), make(map[string]int)
+		}
+		for i, zone := range zones {
+			r := min(i, 1)
+			if have, ok := terrRank[z.Territory][zone]; !ok || r < have {
+				byTerr[z.Territory][zone], terrRank[z.Territory][zone] = z.Other, r
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(windows))
+	for k := range windows {
+		ids = append(ids, k)
+	}
+	sort.Strings(ids)
+
+	b := new(bytes.Buffer)
+	b.WriteString("package tz\n\n")
+	b.WriteString("// windowsZones maps Windows timezone IDs to a zone by territory; \"001\" is the\n")
+	b.WriteString("// default. Generated by gen.go from cldr/windowsZones.xml.\n")
+	b.WriteString("var windowsZones = map[string]map[string]string{\n")
+	for _, id := range ids {
+		fmt.Fprintf(b, "\t%q: {", id)
+		for i, t := range sortedKeys(windows[id]) {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "%q: %q", t, windows[id][t])
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("// territoryWindows maps zones to a Windows timezone ID by territory, if it's\n")
+	b.WriteString("// different from zoneWindows.\n")
+	b.WriteString("var territoryWindows = map[string]map[string]string{\n")
+	terrs := make([]string, 0, len(byTerr))
+	for k := range byTerr {
+		terrs = append(terrs, k)
+	}
+	sort.Strings(terrs)
+	for _, terr := range terrs {
+		var (
+			m     = byTerr[terr]
+			zones []string
+		)
+		for _, zone := range sortedKeys(m) {
+			if reverse[zone] != m[zone] {
+				zones = append(zones, zone)
+			}
+		}
+		if len(zones) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "\t%q: {", terr)
+		for i, zone := range zones {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "%q: %q", zone, m[zone])
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("// zoneWindows maps zones to a Windows timezone ID.\n")
+	b.WriteString("var zoneWindows = map[string]string{\n")
+	for _, k := range sortedKeys(reverse) {
+		if !strings.HasPrefix(k, "Etc/") {
+			fmt.Fprintf(b, "\t%q: %q,\n", k, reverse[k])
+		}
+	}
+	b.WriteString("}\n")
+	write("windows.go", b.Bytes())
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func write(file string, src []byte) {
+	src, err := format.Source(src)
+	if err != nil {
+		panic(err)
+	}
+	err = os.WriteFile(file, src, 0o644)
+	if err != nil {
+		panic(err)
 	}
-	fmt.Println("}")
 }
@@ -0,0 +1,78 @@
+package tz
+
+import (
+	"html/template"
+	"slices"
+	"strings"
+)
+
+// SelectOptions are options for HTMLSelect().
+type SelectOptions struct {
+	Name       string // name attribute; defaults to "timezone".
+	ID         string // id attribute; not added if blank.
+	SortOffset bool   // Sort zones in a country by offset, rather than by name.
+	IncludeUTC bool   // Add an option for UTC at the top.
+}
+
+// HTMLSelect renders a <select> with an <optgroup> for every country, and an
+// <option> for every zone in that country. The countries are sorted by name.
+//
+// The value of the options is the same as String(), and can be read back with
+// UnmarshalText() or Scan(). The selected zone may be nil.
+func HTMLSelect(selected *Zone, opts SelectOptions) template.HTML {
+	name := opts.Name
+	if name == "" {
+		name = "timezone"
+	}
+
+	countries := slices.Clone(Countries())
+	slices.SortStableFunc(countries, func(a, b *Country) int { return strings.Compare(a.Name, b.Name) })
+
+	var b strings.Builder
+	b.WriteString(`<select name="`)
+	b.WriteString(template.HTMLEscapeString(name))
+	b.WriteString(`"`)
+	if opts.ID != "" {
+		b.WriteString(` id="`)
+		b.WriteString(template.HTMLEscapeString(opts.ID))
+		b.WriteString(`"`)
+	}
+	b.WriteString(">\n")
+
+	if opts.IncludeUTC {
+		writeOption(&b, UTC, selected, "UTC")
+	}
+	for _, c := range countries {
+		if len(c.Zones) == 0 {
+			continue
+		}
+		zones := c.Zones
+		if opts.SortOffset {
+			zones = slices.Clone(zones)
+			slices.SortStableFunc(zones, func(a, b *Zone) int { return a.Offset() - b.Offset() })
+		}
+
+		b.WriteString(`<optgroup label="`)
+		b.WriteString(template.HTMLEscapeString(c.Name))
+		b.WriteString("\">\n")
+		for _, z := range zones {
+			writeOption(&b, z, selected, z.label())
+		}
+		b.WriteString("</optgroup>\n")
+	}
+
+	b.WriteString("</select>")
+	return template.HTML(b.String())
+}
+
+func writeOption(b *strings.Builder, z, selected *Zone, label string) {
+	b.WriteString(`<option value="`)
+	b.WriteString(template.HTMLEscapeString(z.String()))
+	b.WriteString(`"`)
+	if selected != nil && z.CountryCode == selected.CountryCode && z.Zone == selected.Zone {
+		b.WriteString(" selected")
+	}
+	b.WriteString(">")
+	b.WriteString(template.HTMLEscapeString(label))
+	b.WriteString("</option>\n")
+}
@@ -0,0 +1,69 @@
+package tz
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestHTMLSelect(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		have := string(HTMLSelect(MustNew("ID", "Asia/Makassar"), SelectOptions{}))
+
+		for _, want := range []string{
+			`<select name="timezone">` + "\n" + `<optgroup label="Afghanistan">`,
+			"<optgroup label=\"Indonesia\">\n" +
+				"<option value=\"ID.Asia/Jakarta\">Asia/Jakarta (WIB) – Java, Sumatra</option>\n" +
+				"<option value=\"ID.Asia/Jayapura\">Asia/Jayapura (WIT) – New Guinea (West Papua / Irian Jaya), Malukus/Moluccas</option>\n" +
+				"<option value=\"ID.Asia/Makassar\" selected>Asia/Makassar (WITA) – Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)</option>\n" +
+				"<option value=\"ID.Asia/Pontianak\">Asia/Pontianak (WIB) – Borneo (west, central)</option>\n" +
+				"</optgroup>\n",
+			`<optgroup label="Antigua &amp; Barbuda">`,
+			"</optgroup>\n</select>",
+		} {
+			if !strings.Contains(have, want) {
+				t.Errorf("doesn't contain:\n%s", want)
+			}
+		}
+		if strings.Contains(have, "Bouvet") {
+			t.Error("contains country without zones")
+		}
+		if n := strings.Count(have, " selected"); n != 1 {
+			t.Errorf("selected %d times", n)
+		}
+
+		for _, m := range regexp.MustCompile(`value="(.*?)"`).FindAllStringSubmatch(have, -1) {
+			var z Zone
+			err := z.UnmarshalText([]byte(m[1]))
+			if err != nil {
+				t.Error(err)
+			}
+			if z.String() != m[1] {
+				t.Errorf("\nhave: %s\nwant: %s", z.String(), m[1])
+			}
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+		have := string(HTMLSelect(UTC, SelectOptions{
+			Name:       `x"y`,
+			ID:         "tz",
+			SortOffset: true,
+			IncludeUTC: true,
+		}))
+
+		for _, want := range []string{
+			`<select name="x&#34;y" id="tz">` + "\n" + `<option value=".UTC" selected>UTC</option>` + "\n",
+			"<optgroup label=\"Indonesia\">\n" +
+				"<option value=\"ID.Asia/Jakarta\">Asia/Jakarta (WIB) – Java, Sumatra</option>\n" +
+				"<option value=\"ID.Asia/Pontianak\">Asia/Pontianak (WIB) – Borneo (west, central)</option>\n" +
+				"<option value=\"ID.Asia/Makassar\">Asia/Makassar (WITA) – Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)</option>\n" +
+				"<option value=\"ID.Asia/Jayapura\">Asia/Jayapura (WIT) – New Guinea (West Papua / Irian Jaya), Malukus/Moluccas</option>\n" +
+				"</optgroup>\n",
+		} {
+			if !strings.Contains(have, want) {
+				t.Errorf("doesn't contain:\n%s", want)
+			}
+		}
+	})
+}
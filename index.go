@@ -0,0 +1,100 @@
+package tz
+
+import (
+	"sort"
+	"sync"
+)
+
+type countryZone struct{ ccode, zone string }
+
+// Lookup indexes, built once from Zones and aliases.
+var (
+	indexOnce     sync.Once
+	byZone        map[string]*Zone      // Asia/Makassar → first zone in Zones.
+	byCountry     map[string][]*Zone    // ID → all zones for Indonesia.
+	byCountryZone map[countryZone]*Zone // ID, Asia/Makassar → zone.
+	byAbbr        map[string][]*Zone    // WITA → all zones using it.
+	byAlias       map[*Zone][]string    // NL.Europe/Brussels → Europe/Amsterdam.
+)
+
+func buildIndex() {
+	indexOnce.Do(func() {
+		byZone = make(map[string]*Zone, len(Zones)+len(aliases))
+		byCountry = make(map[string][]*Zone, 256)
+		byCountryZone = make(map[countryZone]*Zone, len(Zones)+len(aliases))
+		byAbbr = map[string][]*Zone{"UTC": {UTC}}
+		for _, z := range Zones {
+			if _, ok := byZone[z.Zone]; !ok {
+				byZone[z.Zone] = z
+			}
+			byCountry[z.CountryCode] = append(byCountry[z.CountryCode], z)
+			byCountryZone[countryZone{z.CountryCode, z.Zone}] = z
+			for _, a := range z.Abbr {
+				byAbbr[a] = append(byAbbr[a], z)
+			}
+		}
+		for _, c := range countries {
+			c.Zones = byCountry[c.Code]
+		}
+
+		byAlias = make(map[*Zone][]string)
+		for alias, target := range aliases {
+			if _, ok := byZone[alias]; ok {
+				continue
+			}
+			z, ok := byZone[target]
+			if !ok {
+				continue
+			}
+			byZone[alias] = z
+			var targets []*Zone
+			for _, zz := range Zones {
+				if zz.Zone == target {
+					byCountryZone[countryZone{zz.CountryCode, alias}] = zz
+					targets = append(targets, zz)
+				}
+			}
+
+			// Only use the country from zone.tab if the target is used in that
+			// country: Europe/Amsterdam is NL.Europe/Brussels.
+			if zz, ok := byCountryZone[countryZone{aliasCountries[alias], target}]; ok {
+				targets = []*Zone{zz}
+			}
+			for _, zz := range targets {
+				byAlias[zz] = append(byAlias[zz], alias)
+			}
+		}
+		for _, a := range byAlias {
+			sort.Strings(a)
+		}
+	})
+}
+
+// ByZone gets the first zone for the zone name, resolving aliases. For example
+// "Asia/Saigon" will return VN.Asia/Ho_Chi_Minh.
+//
+// Returns nil if there is no such zone.
+func ByZone(zone string) *Zone {
+	loadLocations()
+	return byZone[zone]
+}
+
+// ByCountry gets all zones for the country code. For example "ID" will return
+// the four zones in Indonesia.
+//
+// The returned slice is shared and should not be modified. Returns nil if there
+// are no zones for this country.
+func ByCountry(ccode string) []*Zone {
+	loadLocations()
+	return byCountry[ccode]
+}
+
+// Lookup gets the zone for the exact country code and zone name, resolving
+// aliases.
+//
+// Unlike New() this doesn't do any fallbacks; it returns nil if the zone
+// doesn't exist or if it doesn't exist in this country.
+func Lookup(ccode, zone string) *Zone {
+	loadLocations()
+	return byCountryZone[countryZone{ccode, zone}]
+}
@@ -0,0 +1,223 @@
+package tz
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestByZone(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Asia/Makassar", "ID.Asia/Makassar"},
+		{"Asia/Saigon", "VN.Asia/Ho_Chi_Minh"}, // Alias
+		{"Europe/Brussels", "BE.Europe/Brussels"},
+		{"Asia/Denpasar", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			have := ByZone(tt.in).String()
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestByCountry(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"ID", []string{"ID.Asia/Jakarta", "ID.Asia/Jayapura", "ID.Asia/Makassar", "ID.Asia/Pontianak"}},
+		{"NL", []string{"NL.Europe/Brussels"}},
+		{"XX", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var have []string
+			for _, z := range ByCountry(tt.in) {
+				have = append(have, z.String())
+			}
+			if len(have) != len(tt.want) {
+				t.Fatalf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+			for i := range have {
+				if have[i] != tt.want[i] {
+					t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		inC, inZ string
+		want     string
+	}{
+		{"ID", "Asia/Makassar", "ID.Asia/Makassar"},
+		{"VN", "Asia/Saigon", "VN.Asia/Ho_Chi_Minh"}, // Alias
+		{"NL", "Europe/Brussels", "NL.Europe/Brussels"},
+		{"NL", "Asia/Makassar", ""}, // Wrong country
+		{"", "Asia/Makassar", ""},
+		{"ID", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.inC+tt.inZ, func(t *testing.T) {
+			have := Lookup(tt.inC, tt.inZ).String()
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupAllocs(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"ByZone", func() { ByZone("Asia/Makassar") }},
+		{"ByCountry", func() { ByCountry("ID") }},
+		{"Lookup", func() { Lookup("ID", "Asia/Makassar") }},
+		{"New", func() { New("ID", "Asia/Makassar") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := testing.AllocsPerRun(100, tt.f); n != 0 {
+				t.Errorf("%v allocations", n)
+			}
+		})
+	}
+}
+
+// newLinear is New() before it used the indexes, as a baseline for the
+// benchmarks. This is copied verbatim; the loadLocationOnce.Do() is a no-op as
+// the benchmark calls loadLocations() first.
+func newLinear(ccode, zone string) (*Zone, error) {
+	// Add time.Location to all the zones. This is about 68k memory without the
+	// loaded zones, and 670k with. Not super huge, but kinda large. Also takes
+	// about 12ms on my laptop.
+	loadLocationOnce.Do(func() {
+		for _, z := range Zones {
+			var err error
+			z.Location, err = time.LoadLocation(z.Zone)
+			if err != nil {
+				if strings.Contains(err.Error(), "unknown time zone") {
+					fmt.Fprintf(os.Stderr, "warning: zgo.at/tz: %s; you probably need to update your tzdata or zoneinfo\n", err)
+				}
+			}
+		}
+	})
+
+	if zone == "UTC" {
+		return UTC, nil
+	}
+	if a, ok := aliases[zone]; ok {
+		zone = a
+	}
+	if strings.HasPrefix(zone, "Etc/") {
+		zone = zone[4:]
+		if zone == "UTC" || zone == "GMT" || zone == "Unknown" {
+			return UTC, nil
+		}
+		if !strings.HasPrefix(zone, "GMT") {
+			return nil, fmt.Errorf("invalid Etc/ timezone: %q", zone)
+		}
+		o, err := strconv.ParseInt(zone[3:], 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		off := int(o) * -60 // + and - are reversed in Etc/ listings
+
+		// If we have a country match the first one for this country that
+		// corresponds to the offset.
+		for _, z := range Zones {
+			if z.CountryCode == ccode && z.Offset() == off {
+				return z, nil
+			}
+		}
+		// No matches for this offset, which shouldn't happen, but return the
+		// first for this country.
+		if ccode != "" {
+			for _, z := range Zones {
+				if z.CountryCode == ccode {
+					return z, nil
+				}
+			}
+		}
+
+		return nil, fmt.Errorf("unknown timezone: %q %q", ccode, zone)
+	}
+
+	// No zone name but country given; just get the first zone for that country,
+	// which is better than nothing.
+	if zone == "" && ccode != "" {
+		for _, z := range Zones {
+			if z.CountryCode == ccode {
+				return z, nil
+			}
+		}
+	}
+
+	var match *Zone
+	for _, z := range Zones {
+		if (ccode == "" || z.CountryCode == ccode) && z.Zone == zone {
+			return z, nil
+		}
+		if match == nil && z.Zone == zone {
+			match = z
+		}
+	}
+
+	if match != nil {
+		return match, nil
+	}
+	return nil, fmt.Errorf("unknown timezone: %q %q", ccode, zone)
+}
+
+func BenchmarkNew(b *testing.B) {
+	tests := []struct{ ccode, zone string }{
+		{"AD", "Europe/Andorra"},  // First in Zones.
+		{"ID", "Asia/Makassar"},   // Somewhere in the middle.
+		{"ZW", "Africa/Maputo"},   // Last in Zones.
+		{"NL", "Asia/Makassar"},   // Wrong country.
+		{"ZW", "Asia/Denpasar"},   // Doesn't exist.
+		{"", "Europe/Amsterdam"},  // Alias
+		{"ZW", ""},                // Country only
+		{"SG", "Etc/GMT-8"},       // Offset
+		{"ZZ", "America/Chicago"}, // Wrong country
+	}
+
+	for _, tt := range tests {
+		b.Run("linear/"+tt.ccode+tt.zone, func(b *testing.B) {
+			loadLocations()
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				newLinear(tt.ccode, tt.zone)
+			}
+		})
+		b.Run("New/"+tt.ccode+tt.zone, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				New(tt.ccode, tt.zone)
+			}
+		})
+		b.Run("Lookup/"+tt.ccode+tt.zone, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				Lookup(tt.ccode, tt.zone)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package tz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ZoneJSON wraps a Zone to marshal it as a JSON object, rather than the
+// "ID.Asia/Makassar" string that Zone uses:
+//
+//	{
+//	  "country_code":   "ID",
+//	  "zone":           "Asia/Makassar",
+//	  "country_name":   "Indonesia",
+//	  "comments":       "Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)",
+//	  "abbr":           ["WITA"],
+//	  "offset_minutes": 480,
+//	  "offset_rfc3339": "+08:00"
+//	}
+//
+// The offset is the offset that is currently valid.
+type ZoneJSON struct{ *Zone }
+
+type zoneObject struct {
+	CountryCode   string   `json:"country_code"`
+	Zone          string   `json:"zone"`
+	CountryName   string   `json:"country_name"`
+	Comments      string   `json:"comments"`
+	Abbr          []string `json:"abbr"`
+	OffsetMinutes int      `json:"offset_minutes"`
+	OffsetRFC3339 string   `json:"offset_rfc3339"`
+}
+
+// MarshalJSON converts the zone to a JSON object.
+func (t ZoneJSON) MarshalJSON() ([]byte, error) {
+	if t.Zone == nil {
+		return []byte("null"), nil
+	}
+	abbr := t.Abbr
+	if abbr == nil {
+		abbr = []string{}
+	}
+	return json.Marshal(zoneObject{
+		CountryCode:   t.CountryCode,
+		Zone:          t.Zone.Zone,
+		CountryName:   t.CountryName,
+		Comments:      t.Comments,
+		Abbr:          abbr,
+		OffsetMinutes: t.Offset(),
+		OffsetRFC3339: t.OffsetRFC3339(),
+	})
+}
+
+// UnmarshalJSON reads the zone from either a JSON object or a string.
+func (t *ZoneJSON) UnmarshalJSON(v []byte) error {
+	if bytes.Equal(v, []byte("null")) {
+		return nil
+	}
+	// Don't decode in to t.Zone, as that's usually one of the shared entries in
+	// Zones.
+	z := new(Zone)
+	if err := z.UnmarshalJSON(v); err != nil {
+		return err
+	}
+	t.Zone = z
+	return nil
+}
+
+// UnmarshalJSON reads the zone from either a "ID.Asia/Makassar" string or a
+// JSON object as created by ZoneJSON; only the country_code and zone fields are
+// used for objects.
+func (t *Zone) UnmarshalJSON(v []byte) error {
+	v = bytes.TrimSpace(v)
+	switch {
+	case bytes.Equal(v, []byte("null")):
+		return nil
+	case len(v) > 0 && v[0] == '"':
+		var s string
+		err := json.Unmarshal(v, &s)
+		if err != nil {
+			return err
+		}
+		return t.UnmarshalText([]byte(s))
+	case len(v) > 0 && v[0] == '{':
+		var o zoneObject
+		err := json.Unmarshal(v, &o)
+		if err != nil {
+			return err
+		}
+		z, err := New(o.CountryCode, o.Zone)
+		if z != nil {
+			*t = *z
+		}
+		return err
+	default:
+		return fmt.Errorf("tz.Zone.UnmarshalJSON: %w: must be a string or object, not %s", ErrInvalidFormat, v)
+	}
+}
@@ -0,0 +1,97 @@
+package tz
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestZoneJSON(t *testing.T) {
+	tests := []struct {
+		in   *Zone
+		want string
+	}{
+		{MustNew("", "Asia/Makassar"), `{"country_code":"ID","zone":"Asia/Makassar","country_name":"Indonesia","comments":"Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)","abbr":["WITA"],"offset_minutes":480,"offset_rfc3339":"+08:00"}`},
+		{MustNew("", "Asia/Kabul"), `{"country_code":"AF","zone":"Asia/Kabul","country_name":"Afghanistan","comments":"","abbr":[],"offset_minutes":270,"offset_rfc3339":"+04:30"}`},
+		{UTC, `{"country_code":"","zone":"UTC","country_name":"UTC","comments":"","abbr":["UTC"],"offset_minutes":0,"offset_rfc3339":"UTC"}`},
+		{nil, `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in.String(), func(t *testing.T) {
+			j, err := json.Marshal(ZoneJSON{tt.in})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(j) != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", j, tt.want)
+			}
+
+			var back ZoneJSON
+			err = json.Unmarshal(j, &back)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if back.String() != tt.in.String() {
+				t.Errorf("\nhave: %s\nwant: %s", back, tt.in)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr string
+	}{
+		{`"ID.Asia/Makassar"`, "ID.Asia/Makassar", ""},
+		{`{"country_code": "ID", "zone": "Asia/Makassar"}`, "ID.Asia/Makassar", ""},
+		{`{"zone": "Asia/Makassar", "offset_minutes": 1}`, "ID.Asia/Makassar", ""},
+		{`null`, "", ""},
+
+		{`"Asia/Makassar"`, "", "invalid value"},
+		{`{"country_code": "ID", "zone": "Asia/Denpasar"}`, "", "unknown timezone"},
+		{`42`, "", "must be a string or object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var s struct{ Zone *Zone }
+			err := json.Unmarshal([]byte(`{"Zone": `+tt.in+`}`), &s)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("\nout:  %#v\nwant: %#v\n", err, tt.wantErr)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+			if have := s.Zone.String(); have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+
+			// Marshals as the string form.
+			if s.Zone != nil {
+				j, err := json.Marshal(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := `{"Zone":"` + tt.want + `"}`; string(j) != want {
+					t.Errorf("\nhave: %s\nwant: %s", j, want)
+				}
+			}
+		})
+	}
+}
+
+func TestZoneJSONUnmarshalShared(t *testing.T) {
+	j := ZoneJSON{MustNew("ID", "Asia/Makassar")}
+	err := json.Unmarshal([]byte(`"NL.Europe/Brussels"`), &j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := j.String(); have != "NL.Europe/Brussels" {
+		t.Errorf("have: %s", have)
+	}
+	if have := MustNew("ID", "Asia/Makassar").String(); have != "ID.Asia/Makassar" {
+		t.Errorf("Zones entry modified: %s", have)
+	}
+}
@@ -2,427 +2,681 @@ package tz
 
 // Zones is a list of all timezones by country.
 var Zones = []*Zone{
-	{CountryCode: "AD", Zone: "Europe/Andorra", Abbr: []string{"CEST", "CET"}, CountryName: "Andorra", Comments: ""},
-	{CountryCode: "AE", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "United Arab Emirates", Comments: "Crozet"},
-	{CountryCode: "AF", Zone: "Asia/Kabul", Abbr: []string(nil), CountryName: "Afghanistan", Comments: ""},
-	{CountryCode: "AG", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Antigua & Barbuda", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "AI", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Anguilla", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "AL", Zone: "Europe/Tirane", Abbr: []string{"CEST", "CET"}, CountryName: "Albania", Comments: ""},
-	{CountryCode: "AM", Zone: "Asia/Yerevan", Abbr: []string(nil), CountryName: "Armenia", Comments: ""},
-	{CountryCode: "AO", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Angola", Comments: "West Africa Time"},
-	{CountryCode: "AQ", Zone: "Antarctica/Casey", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Casey"},
-	{CountryCode: "AQ", Zone: "Antarctica/Davis", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Davis"},
-	{CountryCode: "AQ", Zone: "Antarctica/Mawson", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Mawson"},
-	{CountryCode: "AQ", Zone: "Antarctica/Palmer", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Palmer"},
-	{CountryCode: "AQ", Zone: "Antarctica/Rothera", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Rothera"},
-	{CountryCode: "AQ", Zone: "Antarctica/Troll", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Troll"},
-	{CountryCode: "AQ", Zone: "Antarctica/Vostok", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Vostok"},
-	{CountryCode: "AQ", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Syowa"},
-	{CountryCode: "AQ", Zone: "Asia/Singapore", Abbr: []string(nil), CountryName: "Antarctica", Comments: "peninsular Malaysia, Concordia"},
-	{CountryCode: "AQ", Zone: "Pacific/Auckland", Abbr: []string{"NZDT", "NZST"}, CountryName: "Antarctica", Comments: "New Zealand time"},
-	{CountryCode: "AQ", Zone: "Pacific/Port_Moresby", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d’Urville"},
-	{CountryCode: "AR", Zone: "America/Argentina/Buenos_Aires", Abbr: []string(nil), CountryName: "Argentina", Comments: "Buenos Aires (BA, CF)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Catamarca", Abbr: []string(nil), CountryName: "Argentina", Comments: "Catamarca (CT), Chubut (CH)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Cordoba", Abbr: []string(nil), CountryName: "Argentina", Comments: "most areas: CB, CC, CN, ER, FM, MN, SE, SF"},
-	{CountryCode: "AR", Zone: "America/Argentina/Jujuy", Abbr: []string(nil), CountryName: "Argentina", Comments: "Jujuy (JY)"},
-	{CountryCode: "AR", Zone: "America/Argentina/La_Rioja", Abbr: []string(nil), CountryName: "Argentina", Comments: "La Rioja (LR)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Mendoza", Abbr: []string(nil), CountryName: "Argentina", Comments: "Mendoza (MZ)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Rio_Gallegos", Abbr: []string(nil), CountryName: "Argentina", Comments: "Santa Cruz (SC)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Salta", Abbr: []string(nil), CountryName: "Argentina", Comments: "Salta (SA, LP, NQ, RN)"},
-	{CountryCode: "AR", Zone: "America/Argentina/San_Juan", Abbr: []string(nil), CountryName: "Argentina", Comments: "San Juan (SJ)"},
-	{CountryCode: "AR", Zone: "America/Argentina/San_Luis", Abbr: []string(nil), CountryName: "Argentina", Comments: "San Luis (SL)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Tucuman", Abbr: []string(nil), CountryName: "Argentina", Comments: "Tucumán (TM)"},
-	{CountryCode: "AR", Zone: "America/Argentina/Ushuaia", Abbr: []string(nil), CountryName: "Argentina", Comments: "Tierra del Fuego (TF)"},
-	{CountryCode: "AS", Zone: "Pacific/Pago_Pago", Abbr: []string{"SST"}, CountryName: "Samoa (American)", Comments: "Midway"},
-	{CountryCode: "AT", Zone: "Europe/Vienna", Abbr: []string{"CEST", "CET"}, CountryName: "Austria", Comments: ""},
-	{CountryCode: "AU", Zone: "Antarctica/Macquarie", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "Macquarie Island"},
-	{CountryCode: "AU", Zone: "Asia/Tokyo", Abbr: []string{"JST"}, CountryName: "Australia", Comments: "Eyre Bird Observatory"},
-	{CountryCode: "AU", Zone: "Australia/Adelaide", Abbr: []string{"ACDT", "ACST"}, CountryName: "Australia", Comments: "South Australia"},
-	{CountryCode: "AU", Zone: "Australia/Brisbane", Abbr: []string{"AEST"}, CountryName: "Australia", Comments: "Queensland (most areas)"},
-	{CountryCode: "AU", Zone: "Australia/Broken_Hill", Abbr: []string{"ACDT", "ACST"}, CountryName: "Australia", Comments: "New South Wales (Yancowinna)"},
-	{CountryCode: "AU", Zone: "Australia/Darwin", Abbr: []string{"ACST"}, CountryName: "Australia", Comments: "Northern Territory"},
-	{CountryCode: "AU", Zone: "Australia/Eucla", Abbr: []string(nil), CountryName: "Australia", Comments: "Western Australia (Eucla)"},
-	{CountryCode: "AU", Zone: "Australia/Hobart", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "Tasmania"},
-	{CountryCode: "AU", Zone: "Australia/Lindeman", Abbr: []string{"AEST"}, CountryName: "Australia", Comments: "Queensland (Whitsunday Islands)"},
-	{CountryCode: "AU", Zone: "Australia/Lord_Howe", Abbr: []string(nil), CountryName: "Australia", Comments: "Lord Howe Island"},
-	{CountryCode: "AU", Zone: "Australia/Melbourne", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "Victoria"},
-	{CountryCode: "AU", Zone: "Australia/Perth", Abbr: []string{"AWST"}, CountryName: "Australia", Comments: "Western Australia (most areas)"},
-	{CountryCode: "AU", Zone: "Australia/Sydney", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "New South Wales (most areas)"},
-	{CountryCode: "AW", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Aruba", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "AX", Zone: "Europe/Helsinki", Abbr: []string{"EEST", "EET"}, CountryName: "Åland Islands", Comments: ""},
-	{CountryCode: "AZ", Zone: "Asia/Baku", Abbr: []string(nil), CountryName: "Azerbaijan", Comments: ""},
-	{CountryCode: "BA", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Bosnia & Herzegovina", Comments: ""},
-	{CountryCode: "BB", Zone: "America/Barbados", Abbr: []string{"AST"}, CountryName: "Barbados", Comments: ""},
-	{CountryCode: "BD", Zone: "Asia/Dhaka", Abbr: []string(nil), CountryName: "Bangladesh", Comments: ""},
-	{CountryCode: "BE", Zone: "Europe/Brussels", Abbr: []string{"CEST", "CET"}, CountryName: "Belgium", Comments: ""},
-	{CountryCode: "BF", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Burkina Faso", Comments: ""},
-	{CountryCode: "BG", Zone: "Europe/Sofia", Abbr: []string{"EEST", "EET"}, CountryName: "Bulgaria", Comments: ""},
-	{CountryCode: "BH", Zone: "Asia/Qatar", Abbr: []string(nil), CountryName: "Bahrain", Comments: ""},
-	{CountryCode: "BI", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Burundi", Comments: "Central Africa Time"},
-	{CountryCode: "BJ", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Benin", Comments: "West Africa Time"},
-	{CountryCode: "BL", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Barthelemy", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "BM", Zone: "Atlantic/Bermuda", Abbr: []string{"ADT", "AST"}, CountryName: "Bermuda", Comments: ""},
-	{CountryCode: "BN", Zone: "Asia/Kuching", Abbr: []string(nil), CountryName: "Brunei", Comments: "Sabah, Sarawak"},
-	{CountryCode: "BO", Zone: "America/La_Paz", Abbr: []string(nil), CountryName: "Bolivia", Comments: ""},
-	{CountryCode: "BQ", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Caribbean NL", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "BR", Zone: "America/Araguaina", Abbr: []string(nil), CountryName: "Brazil", Comments: "Tocantins"},
-	{CountryCode: "BR", Zone: "America/Bahia", Abbr: []string(nil), CountryName: "Brazil", Comments: "Bahia"},
-	{CountryCode: "BR", Zone: "America/Belem", Abbr: []string(nil), CountryName: "Brazil", Comments: "Pará (east), Amapá"},
-	{CountryCode: "BR", Zone: "America/Boa_Vista", Abbr: []string(nil), CountryName: "Brazil", Comments: "Roraima"},
-	{CountryCode: "BR", Zone: "America/Campo_Grande", Abbr: []string(nil), CountryName: "Brazil", Comments: "Mato Grosso do Sul"},
-	{CountryCode: "BR", Zone: "America/Cuiaba", Abbr: []string(nil), CountryName: "Brazil", Comments: "Mato Grosso"},
-	{CountryCode: "BR", Zone: "America/Eirunepe", Abbr: []string(nil), CountryName: "Brazil", Comments: "Amazonas (west)"},
-	{CountryCode: "BR", Zone: "America/Fortaleza", Abbr: []string(nil), CountryName: "Brazil", Comments: "Brazil (northeast: MA, PI, CE, RN, PB)"},
-	{CountryCode: "BR", Zone: "America/Maceio", Abbr: []string(nil), CountryName: "Brazil", Comments: "Alagoas, Sergipe"},
-	{CountryCode: "BR", Zone: "America/Manaus", Abbr: []string(nil), CountryName: "Brazil", Comments: "Amazonas (east)"},
-	{CountryCode: "BR", Zone: "America/Noronha", Abbr: []string(nil), CountryName: "Brazil", Comments: "Atlantic islands"},
-	{CountryCode: "BR", Zone: "America/Porto_Velho", Abbr: []string(nil), CountryName: "Brazil", Comments: "Rondônia"},
-	{CountryCode: "BR", Zone: "America/Recife", Abbr: []string(nil), CountryName: "Brazil", Comments: "Pernambuco"},
-	{CountryCode: "BR", Zone: "America/Rio_Branco", Abbr: []string(nil), CountryName: "Brazil", Comments: "Acre"},
-	{CountryCode: "BR", Zone: "America/Santarem", Abbr: []string(nil), CountryName: "Brazil", Comments: "Pará (west)"},
-	{CountryCode: "BR", Zone: "America/Sao_Paulo", Abbr: []string(nil), CountryName: "Brazil", Comments: "Brazil (southeast: GO, DF, MG, ES, RJ, SP, PR, SC, RS)"},
-	{CountryCode: "BS", Zone: "America/Toronto", Abbr: []string{"EDT", "EST"}, CountryName: "Bahamas", Comments: "Eastern - ON & QC (most areas)"},
-	{CountryCode: "BT", Zone: "Asia/Thimphu", Abbr: []string(nil), CountryName: "Bhutan", Comments: ""},
-	{CountryCode: "BW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Botswana", Comments: "Central Africa Time"},
-	{CountryCode: "BY", Zone: "Europe/Minsk", Abbr: []string(nil), CountryName: "Belarus", Comments: ""},
-	{CountryCode: "BZ", Zone: "America/Belize", Abbr: []string{"CST"}, CountryName: "Belize", Comments: ""},
-	{CountryCode: "CA", Zone: "America/Cambridge_Bay", Abbr: []string{"MDT", "MST"}, CountryName: "Canada", Comments: "Mountain - NU (west)"},
-	{CountryCode: "CA", Zone: "America/Dawson", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - Yukon (west)"},
-	{CountryCode: "CA", Zone: "America/Dawson_Creek", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - BC (Dawson Cr, Ft St John)"},
-	{CountryCode: "CA", Zone: "America/Edmonton", Abbr: []string{"MDT", "MST"}, CountryName: "Canada", Comments: "Mountain - AB, BC(E), NT(E), SK(W)"},
-	{CountryCode: "CA", Zone: "America/Fort_Nelson", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - BC (Ft Nelson)"},
-	{CountryCode: "CA", Zone: "America/Glace_Bay", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - NS (Cape Breton)"},
-	{CountryCode: "CA", Zone: "America/Goose_Bay", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - Labrador (most areas)"},
-	{CountryCode: "CA", Zone: "America/Halifax", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - NS (most areas), PE"},
-	{CountryCode: "CA", Zone: "America/Inuvik", Abbr: []string{"MDT", "MST"}, CountryName: "Canada", Comments: "Mountain - NT (west)"},
-	{CountryCode: "CA", Zone: "America/Iqaluit", Abbr: []string{"EDT", "EST"}, CountryName: "Canada", Comments: "Eastern - NU (most areas)"},
-	{CountryCode: "CA", Zone: "America/Moncton", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - New Brunswick"},
-	{CountryCode: "CA", Zone: "America/Panama", Abbr: []string{"EST"}, CountryName: "Canada", Comments: "EST - ON (Atikokan), NU (Coral H)"},
-	{CountryCode: "CA", Zone: "America/Phoenix", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - AZ (most areas), Creston BC"},
-	{CountryCode: "CA", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Canada", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "CA", Zone: "America/Rankin_Inlet", Abbr: []string{"CDT", "CST"}, CountryName: "Canada", Comments: "Central - NU (central)"},
-	{CountryCode: "CA", Zone: "America/Regina", Abbr: []string{"CST"}, CountryName: "Canada", Comments: "CST - SK (most areas)"},
-	{CountryCode: "CA", Zone: "America/Resolute", Abbr: []string{"CDT", "CST"}, CountryName: "Canada", Comments: "Central - NU (Resolute)"},
-	{CountryCode: "CA", Zone: "America/St_Johns", Abbr: []string{"NDT", "NST"}, CountryName: "Canada", Comments: "Newfoundland, Labrador (SE)"},
-	{CountryCode: "CA", Zone: "America/Swift_Current", Abbr: []string{"CST"}, CountryName: "Canada", Comments: "CST - SK (midwest)"},
-	{CountryCode: "CA", Zone: "America/Toronto", Abbr: []string{"EDT", "EST"}, CountryName: "Canada", Comments: "Eastern - ON & QC (most areas)"},
-	{CountryCode: "CA", Zone: "America/Vancouver", Abbr: []string{"PDT", "PST"}, CountryName: "Canada", Comments: "Pacific - BC (most areas)"},
-	{CountryCode: "CA", Zone: "America/Whitehorse", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - Yukon (east)"},
-	{CountryCode: "CA", Zone: "America/Winnipeg", Abbr: []string{"CDT", "CST"}, CountryName: "Canada", Comments: "Central - ON (west), Manitoba"},
-	{CountryCode: "CC", Zone: "Asia/Yangon", Abbr: []string(nil), CountryName: "Cocos (Keeling) Islands", Comments: ""},
-	{CountryCode: "CD", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Congo (Dem. Rep.)", Comments: "West Africa Time"},
-	{CountryCode: "CD", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Congo (Dem. Rep.)", Comments: "Central Africa Time"},
-	{CountryCode: "CF", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Central African Rep.", Comments: "West Africa Time"},
-	{CountryCode: "CG", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Congo (Rep.)", Comments: "West Africa Time"},
-	{CountryCode: "CH", Zone: "Europe/Zurich", Abbr: []string{"CEST", "CET"}, CountryName: "Switzerland", Comments: "Büsingen"},
-	{CountryCode: "CI", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Côte d’Ivoire", Comments: ""},
-	{CountryCode: "CK", Zone: "Pacific/Rarotonga", Abbr: []string(nil), CountryName: "Cook Islands", Comments: ""},
-	{CountryCode: "CL", Zone: "America/Coyhaique", Abbr: []string(nil), CountryName: "Chile", Comments: "Aysén Region"},
-	{CountryCode: "CL", Zone: "America/Punta_Arenas", Abbr: []string(nil), CountryName: "Chile", Comments: "Magallanes Region"},
-	{CountryCode: "CL", Zone: "America/Santiago", Abbr: []string(nil), CountryName: "Chile", Comments: "most of Chile"},
-	{CountryCode: "CL", Zone: "Pacific/Easter", Abbr: []string(nil), CountryName: "Chile", Comments: "Easter Island"},
-	{CountryCode: "CM", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Cameroon", Comments: "West Africa Time"},
-	{CountryCode: "CN", Zone: "Asia/Shanghai", Abbr: []string{"CST"}, CountryName: "China", Comments: "Beijing Time"},
-	{CountryCode: "CN", Zone: "Asia/Urumqi", Abbr: []string(nil), CountryName: "China", Comments: "Xinjiang Time"},
-	{CountryCode: "CO", Zone: "America/Bogota", Abbr: []string(nil), CountryName: "Colombia", Comments: ""},
-	{CountryCode: "CR", Zone: "America/Costa_Rica", Abbr: []string{"CST"}, CountryName: "Costa Rica", Comments: ""},
-	{CountryCode: "CU", Zone: "America/Havana", Abbr: []string{"CDT", "CST"}, CountryName: "Cuba", Comments: ""},
-	{CountryCode: "CV", Zone: "Atlantic/Cape_Verde", Abbr: []string(nil), CountryName: "Cape Verde", Comments: ""},
-	{CountryCode: "CW", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Curaçao", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "CX", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Christmas Island", Comments: "north Vietnam"},
-	{CountryCode: "CY", Zone: "Asia/Famagusta", Abbr: []string{"EEST", "EET"}, CountryName: "Cyprus", Comments: "Northern Cyprus"},
-	{CountryCode: "CY", Zone: "Asia/Nicosia", Abbr: []string{"EEST", "EET"}, CountryName: "Cyprus", Comments: "most of Cyprus"},
-	{CountryCode: "CZ", Zone: "Europe/Prague", Abbr: []string{"CEST", "CET"}, CountryName: "Czech Republic", Comments: ""},
-	{CountryCode: "DE", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Germany", Comments: "most of Germany"},
-	{CountryCode: "DE", Zone: "Europe/Zurich", Abbr: []string{"CEST", "CET"}, CountryName: "Germany", Comments: "Büsingen"},
-	{CountryCode: "DJ", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Djibouti", Comments: ""},
-	{CountryCode: "DK", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Denmark", Comments: "most of Germany"},
-	{CountryCode: "DM", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Dominica", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "DO", Zone: "America/Santo_Domingo", Abbr: []string{"AST"}, CountryName: "Dominican Republic", Comments: ""},
-	{CountryCode: "DZ", Zone: "Africa/Algiers", Abbr: []string{"CET"}, CountryName: "Algeria", Comments: ""},
-	{CountryCode: "EC", Zone: "America/Guayaquil", Abbr: []string(nil), CountryName: "Ecuador", Comments: "Ecuador (mainland)"},
-	{CountryCode: "EC", Zone: "Pacific/Galapagos", Abbr: []string(nil), CountryName: "Ecuador", Comments: "Galápagos Islands"},
-	{CountryCode: "EE", Zone: "Europe/Tallinn", Abbr: []string{"EEST", "EET"}, CountryName: "Estonia", Comments: ""},
-	{CountryCode: "EG", Zone: "Africa/Cairo", Abbr: []string{"EEST", "EET"}, CountryName: "Egypt", Comments: ""},
-	{CountryCode: "EH", Zone: "Africa/El_Aaiun", Abbr: []string(nil), CountryName: "Western Sahara", Comments: ""},
-	{CountryCode: "ER", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Eritrea", Comments: ""},
-	{CountryCode: "ES", Zone: "Africa/Ceuta", Abbr: []string{"CEST", "CET"}, CountryName: "Spain", Comments: "Ceuta, Melilla"},
-	{CountryCode: "ES", Zone: "Atlantic/Canary", Abbr: []string{"WEST", "WET"}, CountryName: "Spain", Comments: "Canary Islands"},
-	{CountryCode: "ES", Zone: "Europe/Madrid", Abbr: []string{"CEST", "CET"}, CountryName: "Spain", Comments: "Spain (mainland)"},
-	{CountryCode: "ET", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Ethiopia", Comments: ""},
-	{CountryCode: "FI", Zone: "Europe/Helsinki", Abbr: []string{"EEST", "EET"}, CountryName: "Finland", Comments: ""},
-	{CountryCode: "FJ", Zone: "Pacific/Fiji", Abbr: []string(nil), CountryName: "Fiji", Comments: ""},
-	{CountryCode: "FK", Zone: "Atlantic/Stanley", Abbr: []string(nil), CountryName: "Falkland Islands", Comments: ""},
-	{CountryCode: "FM", Zone: "Pacific/Guadalcanal", Abbr: []string(nil), CountryName: "Micronesia", Comments: "Pohnpei"},
-	{CountryCode: "FM", Zone: "Pacific/Kosrae", Abbr: []string(nil), CountryName: "Micronesia", Comments: "Kosrae"},
-	{CountryCode: "FM", Zone: "Pacific/Port_Moresby", Abbr: []string(nil), CountryName: "Micronesia", Comments: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d’Urville"},
-	{CountryCode: "FO", Zone: "Atlantic/Faroe", Abbr: []string{"WEST", "WET"}, CountryName: "Faroe Islands", Comments: ""},
-	{CountryCode: "FR", Zone: "Europe/Paris", Abbr: []string{"CEST", "CET"}, CountryName: "France", Comments: ""},
-	{CountryCode: "GA", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Gabon", Comments: "West Africa Time"},
-	{CountryCode: "GB", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Britain (UK)", Comments: ""},
-	{CountryCode: "GD", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Grenada", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "GE", Zone: "Asia/Tbilisi", Abbr: []string(nil), CountryName: "Georgia", Comments: ""},
-	{CountryCode: "GF", Zone: "America/Cayenne", Abbr: []string(nil), CountryName: "French Guiana", Comments: ""},
-	{CountryCode: "GG", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Guernsey", Comments: ""},
-	{CountryCode: "GH", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Ghana", Comments: ""},
-	{CountryCode: "GI", Zone: "Europe/Gibraltar", Abbr: []string{"CEST", "CET"}, CountryName: "Gibraltar", Comments: ""},
-	{CountryCode: "GL", Zone: "America/Danmarkshavn", Abbr: []string{"GMT"}, CountryName: "Greenland", Comments: "National Park (east coast)"},
-	{CountryCode: "GL", Zone: "America/Nuuk", Abbr: []string(nil), CountryName: "Greenland", Comments: "most of Greenland"},
-	{CountryCode: "GL", Zone: "America/Scoresbysund", Abbr: []string(nil), CountryName: "Greenland", Comments: "Scoresbysund/Ittoqqortoormiit"},
-	{CountryCode: "GL", Zone: "America/Thule", Abbr: []string{"ADT", "AST"}, CountryName: "Greenland", Comments: "Thule/Pituffik"},
-	{CountryCode: "GM", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Gambia", Comments: ""},
-	{CountryCode: "GN", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Guinea", Comments: ""},
-	{CountryCode: "GP", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Guadeloupe", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "GQ", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Equatorial Guinea", Comments: "West Africa Time"},
-	{CountryCode: "GR", Zone: "Europe/Athens", Abbr: []string{"EEST", "EET"}, CountryName: "Greece", Comments: ""},
-	{CountryCode: "GS", Zone: "Atlantic/South_Georgia", Abbr: []string(nil), CountryName: "South Georgia & the South Sandwich Islands", Comments: ""},
-	{CountryCode: "GT", Zone: "America/Guatemala", Abbr: []string{"CST"}, CountryName: "Guatemala", Comments: ""},
-	{CountryCode: "GU", Zone: "Pacific/Guam", Abbr: []string{"ChST"}, CountryName: "Guam", Comments: ""},
-	{CountryCode: "GW", Zone: "Africa/Bissau", Abbr: []string{"GMT"}, CountryName: "Guinea-Bissau", Comments: ""},
-	{CountryCode: "GY", Zone: "America/Guyana", Abbr: []string(nil), CountryName: "Guyana", Comments: ""},
-	{CountryCode: "HK", Zone: "Asia/Hong_Kong", Abbr: []string{"HKT"}, CountryName: "Hong Kong", Comments: ""},
-	{CountryCode: "HN", Zone: "America/Tegucigalpa", Abbr: []string{"CST"}, CountryName: "Honduras", Comments: ""},
-	{CountryCode: "HR", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Croatia", Comments: ""},
-	{CountryCode: "HT", Zone: "America/Port-au-Prince", Abbr: []string{"EDT", "EST"}, CountryName: "Haiti", Comments: ""},
-	{CountryCode: "HU", Zone: "Europe/Budapest", Abbr: []string{"CEST", "CET"}, CountryName: "Hungary", Comments: ""},
-	{CountryCode: "ID", Zone: "Asia/Jakarta", Abbr: []string{"WIB"}, CountryName: "Indonesia", Comments: "Java, Sumatra"},
-	{CountryCode: "ID", Zone: "Asia/Jayapura", Abbr: []string{"WIT"}, CountryName: "Indonesia", Comments: "New Guinea (West Papua / Irian Jaya), Malukus/Moluccas"},
-	{CountryCode: "ID", Zone: "Asia/Makassar", Abbr: []string{"WITA"}, CountryName: "Indonesia", Comments: "Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)"},
-	{CountryCode: "ID", Zone: "Asia/Pontianak", Abbr: []string{"WIB"}, CountryName: "Indonesia", Comments: "Borneo (west, central)"},
-	{CountryCode: "IE", Zone: "Europe/Dublin", Abbr: []string{"GMT", "IST"}, CountryName: "Ireland", Comments: ""},
-	{CountryCode: "IL", Zone: "Asia/Jerusalem", Abbr: []string{"IDT", "IST"}, CountryName: "Israel", Comments: ""},
-	{CountryCode: "IM", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Isle of Man", Comments: ""},
-	{CountryCode: "IN", Zone: "Asia/Kolkata", Abbr: []string{"IST"}, CountryName: "India", Comments: ""},
-	{CountryCode: "IO", Zone: "Indian/Chagos", Abbr: []string(nil), CountryName: "British Indian Ocean Territory", Comments: ""},
-	{CountryCode: "IQ", Zone: "Asia/Baghdad", Abbr: []string(nil), CountryName: "Iraq", Comments: ""},
-	{CountryCode: "IR", Zone: "Asia/Tehran", Abbr: []string(nil), CountryName: "Iran", Comments: ""},
-	{CountryCode: "IS", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Iceland", Comments: ""},
-	{CountryCode: "IT", Zone: "Europe/Rome", Abbr: []string{"CEST", "CET"}, CountryName: "Italy", Comments: ""},
-	{CountryCode: "JE", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Jersey", Comments: ""},
-	{CountryCode: "JM", Zone: "America/Jamaica", Abbr: []string{"EST"}, CountryName: "Jamaica", Comments: ""},
-	{CountryCode: "JO", Zone: "Asia/Amman", Abbr: []string(nil), CountryName: "Jordan", Comments: ""},
-	{CountryCode: "JP", Zone: "Asia/Tokyo", Abbr: []string{"JST"}, CountryName: "Japan", Comments: "Eyre Bird Observatory"},
-	{CountryCode: "KE", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Kenya", Comments: ""},
-	{CountryCode: "KG", Zone: "Asia/Bishkek", Abbr: []string(nil), CountryName: "Kyrgyzstan", Comments: ""},
-	{CountryCode: "KH", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Cambodia", Comments: "north Vietnam"},
-	{CountryCode: "KI", Zone: "Pacific/Kanton", Abbr: []string(nil), CountryName: "Kiribati", Comments: "Phoenix Islands"},
-	{CountryCode: "KI", Zone: "Pacific/Kiritimati", Abbr: []string(nil), CountryName: "Kiribati", Comments: "Line Islands"},
-	{CountryCode: "KI", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Kiribati", Comments: "Gilberts, Marshalls, Wake"},
-	{CountryCode: "KM", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Comoros", Comments: ""},
-	{CountryCode: "KN", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Kitts & Nevis", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "KP", Zone: "Asia/Pyongyang", Abbr: []string{"KST"}, CountryName: "Korea (North)", Comments: ""},
-	{CountryCode: "KR", Zone: "Asia/Seoul", Abbr: []string{"KST"}, CountryName: "Korea (South)", Comments: ""},
-	{CountryCode: "KW", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Kuwait", Comments: "Syowa"},
-	{CountryCode: "KY", Zone: "America/Panama", Abbr: []string{"EST"}, CountryName: "Cayman Islands", Comments: "EST - ON (Atikokan), NU (Coral H)"},
-	{CountryCode: "KZ", Zone: "Asia/Almaty", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "most of Kazakhstan"},
-	{CountryCode: "KZ", Zone: "Asia/Aqtau", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Mangghystaū/Mankistau"},
-	{CountryCode: "KZ", Zone: "Asia/Aqtobe", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Aqtöbe/Aktobe"},
-	{CountryCode: "KZ", Zone: "Asia/Atyrau", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Atyraū/Atirau/Gur’yev"},
-	{CountryCode: "KZ", Zone: "Asia/Oral", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "West Kazakhstan"},
-	{CountryCode: "KZ", Zone: "Asia/Qostanay", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Qostanay/Kostanay/Kustanay"},
-	{CountryCode: "KZ", Zone: "Asia/Qyzylorda", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Qyzylorda/Kyzylorda/Kzyl-Orda"},
-	{CountryCode: "LA", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Laos", Comments: "north Vietnam"},
-	{CountryCode: "LB", Zone: "Asia/Beirut", Abbr: []string{"EEST", "EET"}, CountryName: "Lebanon", Comments: ""},
-	{CountryCode: "LC", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Lucia", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "LI", Zone: "Europe/Zurich", Abbr: []string{"CEST", "CET"}, CountryName: "Liechtenstein", Comments: "Büsingen"},
-	{CountryCode: "LK", Zone: "Asia/Colombo", Abbr: []string(nil), CountryName: "Sri Lanka", Comments: ""},
-	{CountryCode: "LR", Zone: "Africa/Monrovia", Abbr: []string{"GMT"}, CountryName: "Liberia", Comments: ""},
-	{CountryCode: "LS", Zone: "Africa/Johannesburg", Abbr: []string{"SAST"}, CountryName: "Lesotho", Comments: ""},
-	{CountryCode: "LT", Zone: "Europe/Vilnius", Abbr: []string{"EEST", "EET"}, CountryName: "Lithuania", Comments: ""},
-	{CountryCode: "LU", Zone: "Europe/Brussels", Abbr: []string{"CEST", "CET"}, CountryName: "Luxembourg", Comments: ""},
-	{CountryCode: "LV", Zone: "Europe/Riga", Abbr: []string{"EEST", "EET"}, CountryName: "Latvia", Comments: ""},
-	{CountryCode: "LY", Zone: "Africa/Tripoli", Abbr: []string{"EET"}, CountryName: "Libya", Comments: ""},
-	{CountryCode: "MA", Zone: "Africa/Casablanca", Abbr: []string(nil), CountryName: "Morocco", Comments: ""},
-	{CountryCode: "MC", Zone: "Europe/Paris", Abbr: []string{"CEST", "CET"}, CountryName: "Monaco", Comments: ""},
-	{CountryCode: "MD", Zone: "Europe/Chisinau", Abbr: []string{"EEST", "EET"}, CountryName: "Moldova", Comments: ""},
-	{CountryCode: "ME", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Montenegro", Comments: ""},
-	{CountryCode: "MF", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Martin (French)", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "MG", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Madagascar", Comments: ""},
-	{CountryCode: "MH", Zone: "Pacific/Kwajalein", Abbr: []string(nil), CountryName: "Marshall Islands", Comments: "Kwajalein"},
-	{CountryCode: "MH", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Marshall Islands", Comments: "Gilberts, Marshalls, Wake"},
-	{CountryCode: "MK", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "North Macedonia", Comments: ""},
-	{CountryCode: "ML", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Mali", Comments: ""},
-	{CountryCode: "MM", Zone: "Asia/Yangon", Abbr: []string(nil), CountryName: "Myanmar (Burma)", Comments: ""},
-	{CountryCode: "MN", Zone: "Asia/Hovd", Abbr: []string(nil), CountryName: "Mongolia", Comments: "Bayan-Ölgii, Hovd, Uvs"},
-	{CountryCode: "MN", Zone: "Asia/Ulaanbaatar", Abbr: []string(nil), CountryName: "Mongolia", Comments: "most of Mongolia"},
-	{CountryCode: "MO", Zone: "Asia/Macau", Abbr: []string{"CST"}, CountryName: "Macau", Comments: ""},
-	{CountryCode: "MP", Zone: "Pacific/Guam", Abbr: []string{"ChST"}, CountryName: "Northern Mariana Islands", Comments: ""},
-	{CountryCode: "MQ", Zone: "America/Martinique", Abbr: []string{"AST"}, CountryName: "Martinique", Comments: ""},
-	{CountryCode: "MR", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Mauritania", Comments: ""},
-	{CountryCode: "MS", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Montserrat", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "MT", Zone: "Europe/Malta", Abbr: []string{"CEST", "CET"}, CountryName: "Malta", Comments: ""},
-	{CountryCode: "MU", Zone: "Indian/Mauritius", Abbr: []string(nil), CountryName: "Mauritius", Comments: ""},
-	{CountryCode: "MV", Zone: "Indian/Maldives", Abbr: []string(nil), CountryName: "Maldives", Comments: "Kerguelen, St Paul I, Amsterdam I"},
-	{CountryCode: "MW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Malawi", Comments: "Central Africa Time"},
-	{CountryCode: "MX", Zone: "America/Bahia_Banderas", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Bahía de Banderas"},
-	{CountryCode: "MX", Zone: "America/Cancun", Abbr: []string{"EST"}, CountryName: "Mexico", Comments: "Quintana Roo"},
-	{CountryCode: "MX", Zone: "America/Chihuahua", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Chihuahua (most areas)"},
-	{CountryCode: "MX", Zone: "America/Ciudad_Juarez", Abbr: []string{"MDT", "MST"}, CountryName: "Mexico", Comments: "Chihuahua (US border - west)"},
-	{CountryCode: "MX", Zone: "America/Hermosillo", Abbr: []string{"MST"}, CountryName: "Mexico", Comments: "Sonora"},
-	{CountryCode: "MX", Zone: "America/Matamoros", Abbr: []string{"CDT", "CST"}, CountryName: "Mexico", Comments: "Coahuila, Nuevo León, Tamaulipas (US border)"},
-	{CountryCode: "MX", Zone: "America/Mazatlan", Abbr: []string{"MST"}, CountryName: "Mexico", Comments: "Baja California Sur, Nayarit (most areas), Sinaloa"},
-	{CountryCode: "MX", Zone: "America/Merida", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Campeche, Yucatán"},
-	{CountryCode: "MX", Zone: "America/Mexico_City", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Central Mexico"},
-	{CountryCode: "MX", Zone: "America/Monterrey", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Durango; Coahuila, Nuevo León, Tamaulipas (most areas)"},
-	{CountryCode: "MX", Zone: "America/Ojinaga", Abbr: []string{"CDT", "CST"}, CountryName: "Mexico", Comments: "Chihuahua (US border - east)"},
-	{CountryCode: "MX", Zone: "America/Tijuana", Abbr: []string{"PDT", "PST"}, CountryName: "Mexico", Comments: "Baja California"},
-	{CountryCode: "MY", Zone: "Asia/Kuching", Abbr: []string(nil), CountryName: "Malaysia", Comments: "Sabah, Sarawak"},
-	{CountryCode: "MY", Zone: "Asia/Singapore", Abbr: []string(nil), CountryName: "Malaysia", Comments: "peninsular Malaysia, Concordia"},
-	{CountryCode: "MZ", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Mozambique", Comments: "Central Africa Time"},
-	{CountryCode: "NA", Zone: "Africa/Windhoek", Abbr: []string{"CAT"}, CountryName: "Namibia", Comments: ""},
-	{CountryCode: "NC", Zone: "Pacific/Noumea", Abbr: []string(nil), CountryName: "New Caledonia", Comments: ""},
-	{CountryCode: "NE", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Niger", Comments: "West Africa Time"},
-	{CountryCode: "NF", Zone: "Pacific/Norfolk", Abbr: []string(nil), CountryName: "Norfolk Island", Comments: ""},
-	{CountryCode: "NG", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Nigeria", Comments: "West Africa Time"},
-	{CountryCode: "NI", Zone: "America/Managua", Abbr: []string{"CST"}, CountryName: "Nicaragua", Comments: ""},
-	{CountryCode: "NL", Zone: "Europe/Brussels", Abbr: []string{"CEST", "CET"}, CountryName: "Netherlands", Comments: ""},
-	{CountryCode: "NO", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Norway", Comments: "most of Germany"},
-	{CountryCode: "NP", Zone: "Asia/Kathmandu", Abbr: []string(nil), CountryName: "Nepal", Comments: ""},
-	{CountryCode: "NR", Zone: "Pacific/Nauru", Abbr: []string(nil), CountryName: "Nauru", Comments: ""},
-	{CountryCode: "NU", Zone: "Pacific/Niue", Abbr: []string(nil), CountryName: "Niue", Comments: ""},
-	{CountryCode: "NZ", Zone: "Pacific/Auckland", Abbr: []string{"NZDT", "NZST"}, CountryName: "New Zealand", Comments: "New Zealand time"},
-	{CountryCode: "NZ", Zone: "Pacific/Chatham", Abbr: []string(nil), CountryName: "New Zealand", Comments: "Chatham Islands"},
-	{CountryCode: "OM", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "Oman", Comments: "Crozet"},
-	{CountryCode: "PA", Zone: "America/Panama", Abbr: []string{"EST"}, CountryName: "Panama", Comments: "EST - ON (Atikokan), NU (Coral H)"},
-	{CountryCode: "PE", Zone: "America/Lima", Abbr: []string(nil), CountryName: "Peru", Comments: ""},
-	{CountryCode: "PF", Zone: "Pacific/Gambier", Abbr: []string(nil), CountryName: "French Polynesia", Comments: "Gambier Islands"},
-	{CountryCode: "PF", Zone: "Pacific/Marquesas", Abbr: []string(nil), CountryName: "French Polynesia", Comments: "Marquesas Islands"},
-	{CountryCode: "PF", Zone: "Pacific/Tahiti", Abbr: []string(nil), CountryName: "French Polynesia", Comments: "Society Islands"},
-	{CountryCode: "PG", Zone: "Pacific/Bougainville", Abbr: []string(nil), CountryName: "Papua New Guinea", Comments: "Bougainville"},
-	{CountryCode: "PG", Zone: "Pacific/Port_Moresby", Abbr: []string(nil), CountryName: "Papua New Guinea", Comments: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d’Urville"},
-	{CountryCode: "PH", Zone: "Asia/Manila", Abbr: []string{"PST"}, CountryName: "Philippines", Comments: ""},
-	{CountryCode: "PK", Zone: "Asia/Karachi", Abbr: []string{"PKT"}, CountryName: "Pakistan", Comments: ""},
-	{CountryCode: "PL", Zone: "Europe/Warsaw", Abbr: []string{"CEST", "CET"}, CountryName: "Poland", Comments: ""},
-	{CountryCode: "PM", Zone: "America/Miquelon", Abbr: []string(nil), CountryName: "St Pierre & Miquelon", Comments: ""},
-	{CountryCode: "PN", Zone: "Pacific/Pitcairn", Abbr: []string(nil), CountryName: "Pitcairn", Comments: ""},
-	{CountryCode: "PR", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Puerto Rico", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "PS", Zone: "Asia/Gaza", Abbr: []string{"EEST", "EET"}, CountryName: "Palestine", Comments: "Gaza Strip"},
-	{CountryCode: "PS", Zone: "Asia/Hebron", Abbr: []string{"EEST", "EET"}, CountryName: "Palestine", Comments: "West Bank"},
-	{CountryCode: "PT", Zone: "Atlantic/Azores", Abbr: []string(nil), CountryName: "Portugal", Comments: "Azores"},
-	{CountryCode: "PT", Zone: "Atlantic/Madeira", Abbr: []string{"WEST", "WET"}, CountryName: "Portugal", Comments: "Madeira Islands"},
-	{CountryCode: "PT", Zone: "Europe/Lisbon", Abbr: []string{"WEST", "WET"}, CountryName: "Portugal", Comments: "Portugal (mainland)"},
-	{CountryCode: "PW", Zone: "Pacific/Palau", Abbr: []string(nil), CountryName: "Palau", Comments: ""},
-	{CountryCode: "PY", Zone: "America/Asuncion", Abbr: []string(nil), CountryName: "Paraguay", Comments: ""},
-	{CountryCode: "QA", Zone: "Asia/Qatar", Abbr: []string(nil), CountryName: "Qatar", Comments: ""},
-	{CountryCode: "RE", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "Réunion", Comments: "Crozet"},
-	{CountryCode: "RO", Zone: "Europe/Bucharest", Abbr: []string{"EEST", "EET"}, CountryName: "Romania", Comments: ""},
-	{CountryCode: "RS", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Serbia", Comments: ""},
-	{CountryCode: "RU", Zone: "Asia/Anadyr", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+09 - Bering Sea"},
-	{CountryCode: "RU", Zone: "Asia/Barnaul", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Altai"},
-	{CountryCode: "RU", Zone: "Asia/Chita", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+06 - Zabaykalsky"},
-	{CountryCode: "RU", Zone: "Asia/Irkutsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+05 - Irkutsk, Buryatia"},
-	{CountryCode: "RU", Zone: "Asia/Kamchatka", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+09 - Kamchatka"},
-	{CountryCode: "RU", Zone: "Asia/Khandyga", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+06 - Tomponsky, Ust-Maysky"},
-	{CountryCode: "RU", Zone: "Asia/Krasnoyarsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Krasnoyarsk area"},
-	{CountryCode: "RU", Zone: "Asia/Magadan", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+08 - Magadan"},
-	{CountryCode: "RU", Zone: "Asia/Novokuznetsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Kemerovo"},
-	{CountryCode: "RU", Zone: "Asia/Novosibirsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Novosibirsk"},
-	{CountryCode: "RU", Zone: "Asia/Omsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+03 - Omsk"},
-	{CountryCode: "RU", Zone: "Asia/Sakhalin", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+08 - Sakhalin Island"},
-	{CountryCode: "RU", Zone: "Asia/Srednekolymsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+08 - Sakha (E), N Kuril Is"},
-	{CountryCode: "RU", Zone: "Asia/Tomsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Tomsk"},
-	{CountryCode: "RU", Zone: "Asia/Ust-Nera", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+07 - Oymyakonsky"},
-	{CountryCode: "RU", Zone: "Asia/Vladivostok", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+07 - Amur River"},
-	{CountryCode: "RU", Zone: "Asia/Yakutsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+06 - Lena River"},
-	{CountryCode: "RU", Zone: "Asia/Yekaterinburg", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+02 - Urals"},
-	{CountryCode: "RU", Zone: "Europe/Astrakhan", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Astrakhan"},
-	{CountryCode: "RU", Zone: "Europe/Kaliningrad", Abbr: []string{"EET"}, CountryName: "Russia", Comments: "MSK-01 - Kaliningrad"},
-	{CountryCode: "RU", Zone: "Europe/Kirov", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "MSK+00 - Kirov"},
-	{CountryCode: "RU", Zone: "Europe/Moscow", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "MSK+00 - Moscow area"},
-	{CountryCode: "RU", Zone: "Europe/Samara", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Samara, Udmurtia"},
-	{CountryCode: "RU", Zone: "Europe/Saratov", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Saratov"},
-	{CountryCode: "RU", Zone: "Europe/Simferopol", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "Crimea"},
-	{CountryCode: "RU", Zone: "Europe/Ulyanovsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Ulyanovsk"},
-	{CountryCode: "RU", Zone: "Europe/Volgograd", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "MSK+00 - Volgograd"},
-	{CountryCode: "RW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Rwanda", Comments: "Central Africa Time"},
-	{CountryCode: "SA", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Saudi Arabia", Comments: "Syowa"},
-	{CountryCode: "SB", Zone: "Pacific/Guadalcanal", Abbr: []string(nil), CountryName: "Solomon Islands", Comments: "Pohnpei"},
-	{CountryCode: "SC", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "Seychelles", Comments: "Crozet"},
-	{CountryCode: "SD", Zone: "Africa/Khartoum", Abbr: []string{"CAT"}, CountryName: "Sudan", Comments: ""},
-	{CountryCode: "SE", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Sweden", Comments: "most of Germany"},
-	{CountryCode: "SG", Zone: "Asia/Singapore", Abbr: []string(nil), CountryName: "Singapore", Comments: "peninsular Malaysia, Concordia"},
-	{CountryCode: "SH", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "St Helena", Comments: ""},
-	{CountryCode: "SI", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Slovenia", Comments: ""},
-	{CountryCode: "SJ", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Svalbard & Jan Mayen", Comments: "most of Germany"},
-	{CountryCode: "SK", Zone: "Europe/Prague", Abbr: []string{"CEST", "CET"}, CountryName: "Slovakia", Comments: ""},
-	{CountryCode: "SL", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Sierra Leone", Comments: ""},
-	{CountryCode: "SM", Zone: "Europe/Rome", Abbr: []string{"CEST", "CET"}, CountryName: "San Marino", Comments: ""},
-	{CountryCode: "SN", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Senegal", Comments: ""},
-	{CountryCode: "SO", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Somalia", Comments: ""},
-	{CountryCode: "SR", Zone: "America/Paramaribo", Abbr: []string(nil), CountryName: "Suriname", Comments: ""},
-	{CountryCode: "SS", Zone: "Africa/Juba", Abbr: []string{"CAT"}, CountryName: "South Sudan", Comments: ""},
-	{CountryCode: "ST", Zone: "Africa/Sao_Tome", Abbr: []string{"GMT"}, CountryName: "Sao Tome & Principe", Comments: ""},
-	{CountryCode: "SV", Zone: "America/El_Salvador", Abbr: []string{"CST"}, CountryName: "El Salvador", Comments: ""},
-	{CountryCode: "SX", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Maarten (Dutch)", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "SY", Zone: "Asia/Damascus", Abbr: []string(nil), CountryName: "Syria", Comments: ""},
-	{CountryCode: "SZ", Zone: "Africa/Johannesburg", Abbr: []string{"SAST"}, CountryName: "Eswatini (Swaziland)", Comments: ""},
-	{CountryCode: "TC", Zone: "America/Grand_Turk", Abbr: []string{"EDT", "EST"}, CountryName: "Turks & Caicos Is", Comments: ""},
-	{CountryCode: "TD", Zone: "Africa/Ndjamena", Abbr: []string{"WAT"}, CountryName: "Chad", Comments: ""},
-	{CountryCode: "TF", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "French S. Terr.", Comments: "Crozet"},
-	{CountryCode: "TF", Zone: "Indian/Maldives", Abbr: []string(nil), CountryName: "French S. Terr.", Comments: "Kerguelen, St Paul I, Amsterdam I"},
-	{CountryCode: "TG", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Togo", Comments: ""},
-	{CountryCode: "TH", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Thailand", Comments: "north Vietnam"},
-	{CountryCode: "TJ", Zone: "Asia/Dushanbe", Abbr: []string(nil), CountryName: "Tajikistan", Comments: ""},
-	{CountryCode: "TK", Zone: "Pacific/Fakaofo", Abbr: []string(nil), CountryName: "Tokelau", Comments: ""},
-	{CountryCode: "TL", Zone: "Asia/Dili", Abbr: []string(nil), CountryName: "East Timor", Comments: ""},
-	{CountryCode: "TM", Zone: "Asia/Ashgabat", Abbr: []string(nil), CountryName: "Turkmenistan", Comments: ""},
-	{CountryCode: "TN", Zone: "Africa/Tunis", Abbr: []string{"CET"}, CountryName: "Tunisia", Comments: ""},
-	{CountryCode: "TO", Zone: "Pacific/Tongatapu", Abbr: []string(nil), CountryName: "Tonga", Comments: ""},
-	{CountryCode: "TR", Zone: "Europe/Istanbul", Abbr: []string(nil), CountryName: "Turkey", Comments: ""},
-	{CountryCode: "TT", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Trinidad & Tobago", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "TV", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Tuvalu", Comments: "Gilberts, Marshalls, Wake"},
-	{CountryCode: "TW", Zone: "Asia/Taipei", Abbr: []string{"CST"}, CountryName: "Taiwan", Comments: ""},
-	{CountryCode: "TZ", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Tanzania", Comments: ""},
-	{CountryCode: "UA", Zone: "Europe/Kyiv", Abbr: []string{"EEST", "EET"}, CountryName: "Ukraine", Comments: "most of Ukraine"},
-	{CountryCode: "UA", Zone: "Europe/Simferopol", Abbr: []string{"MSK"}, CountryName: "Ukraine", Comments: "Crimea"},
-	{CountryCode: "UG", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Uganda", Comments: ""},
-	{CountryCode: "UM", Zone: "Pacific/Pago_Pago", Abbr: []string{"SST"}, CountryName: "US minor outlying islands", Comments: "Midway"},
-	{CountryCode: "UM", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "US minor outlying islands", Comments: "Gilberts, Marshalls, Wake"},
-	{CountryCode: "US", Zone: "America/Adak", Abbr: []string{"HDT", "HST"}, CountryName: "United States", Comments: "Alaska - western Aleutians"},
-	{CountryCode: "US", Zone: "America/Anchorage", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska (most areas)"},
-	{CountryCode: "US", Zone: "America/Boise", Abbr: []string{"MDT", "MST"}, CountryName: "United States", Comments: "Mountain - ID (south), OR (east)"},
-	{CountryCode: "US", Zone: "America/Chicago", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central (most areas)"},
-	{CountryCode: "US", Zone: "America/Denver", Abbr: []string{"MDT", "MST"}, CountryName: "United States", Comments: "Mountain (most areas)"},
-	{CountryCode: "US", Zone: "America/Detroit", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - MI (most areas)"},
-	{CountryCode: "US", Zone: "America/Indiana/Indianapolis", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (most areas)"},
-	{CountryCode: "US", Zone: "America/Indiana/Knox", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - IN (Starke)"},
-	{CountryCode: "US", Zone: "America/Indiana/Marengo", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Crawford)"},
-	{CountryCode: "US", Zone: "America/Indiana/Petersburg", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Pike)"},
-	{CountryCode: "US", Zone: "America/Indiana/Tell_City", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - IN (Perry)"},
-	{CountryCode: "US", Zone: "America/Indiana/Vevay", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Switzerland)"},
-	{CountryCode: "US", Zone: "America/Indiana/Vincennes", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Da, Du, K, Mn)"},
-	{CountryCode: "US", Zone: "America/Indiana/Winamac", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Pulaski)"},
-	{CountryCode: "US", Zone: "America/Juneau", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Juneau area"},
-	{CountryCode: "US", Zone: "America/Kentucky/Louisville", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - KY (Louisville area)"},
-	{CountryCode: "US", Zone: "America/Kentucky/Monticello", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - KY (Wayne)"},
-	{CountryCode: "US", Zone: "America/Los_Angeles", Abbr: []string{"PDT", "PST"}, CountryName: "United States", Comments: "Pacific"},
-	{CountryCode: "US", Zone: "America/Menominee", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - MI (Wisconsin border)"},
-	{CountryCode: "US", Zone: "America/Metlakatla", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Annette Island"},
-	{CountryCode: "US", Zone: "America/New_York", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern (most areas)"},
-	{CountryCode: "US", Zone: "America/Nome", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska (west)"},
-	{CountryCode: "US", Zone: "America/North_Dakota/Beulah", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - ND (Mercer)"},
-	{CountryCode: "US", Zone: "America/North_Dakota/Center", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - ND (Oliver)"},
-	{CountryCode: "US", Zone: "America/North_Dakota/New_Salem", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - ND (Morton rural)"},
-	{CountryCode: "US", Zone: "America/Phoenix", Abbr: []string{"MST"}, CountryName: "United States", Comments: "MST - AZ (most areas), Creston BC"},
-	{CountryCode: "US", Zone: "America/Sitka", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Sitka area"},
-	{CountryCode: "US", Zone: "America/Yakutat", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Yakutat"},
-	{CountryCode: "US", Zone: "Pacific/Honolulu", Abbr: []string{"HST"}, CountryName: "United States", Comments: "Hawaii"},
-	{CountryCode: "UY", Zone: "America/Montevideo", Abbr: []string(nil), CountryName: "Uruguay", Comments: ""},
-	{CountryCode: "UZ", Zone: "Asia/Samarkand", Abbr: []string(nil), CountryName: "Uzbekistan", Comments: "Uzbekistan (west)"},
-	{CountryCode: "UZ", Zone: "Asia/Tashkent", Abbr: []string(nil), CountryName: "Uzbekistan", Comments: "Uzbekistan (east)"},
-	{CountryCode: "VA", Zone: "Europe/Rome", Abbr: []string{"CEST", "CET"}, CountryName: "Vatican City", Comments: ""},
-	{CountryCode: "VC", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Vincent", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "VE", Zone: "America/Caracas", Abbr: []string(nil), CountryName: "Venezuela", Comments: ""},
-	{CountryCode: "VG", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Virgin Islands (UK)", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "VI", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Virgin Islands (US)", Comments: "AST - QC (Lower North Shore)"},
-	{CountryCode: "VN", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Vietnam", Comments: "north Vietnam"},
-	{CountryCode: "VN", Zone: "Asia/Ho_Chi_Minh", Abbr: []string(nil), CountryName: "Vietnam", Comments: "south Vietnam"},
-	{CountryCode: "VU", Zone: "Pacific/Efate", Abbr: []string(nil), CountryName: "Vanuatu", Comments: ""},
-	{CountryCode: "WF", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Wallis & Futuna", Comments: "Gilberts, Marshalls, Wake"},
-	{CountryCode: "WS", Zone: "Pacific/Apia", Abbr: []string(nil), CountryName: "Samoa (western)", Comments: ""},
-	{CountryCode: "YE", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Yemen", Comments: "Syowa"},
-	{CountryCode: "YT", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Mayotte", Comments: ""},
-	{CountryCode: "ZA", Zone: "Africa/Johannesburg", Abbr: []string{"SAST"}, CountryName: "South Africa", Comments: ""},
-	{CountryCode: "ZM", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Zambia", Comments: "Central Africa Time"},
-	{CountryCode: "ZW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Zimbabwe", Comments: "Central Africa Time"},
+	{CountryCode: "AD", Zone: "Europe/Andorra", Abbr: []string{"CEST", "CET"}, CountryName: "Andorra", Comments: "", Latitude: 42.5, Longitude: 1.5167},
+	{CountryCode: "AE", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "United Arab Emirates", Comments: "Crozet", Latitude: 25.3, Longitude: 55.3},
+	{CountryCode: "AF", Zone: "Asia/Kabul", Abbr: []string(nil), CountryName: "Afghanistan", Comments: "", Latitude: 34.5167, Longitude: 69.2},
+	{CountryCode: "AG", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Antigua & Barbuda", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "AI", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Anguilla", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "AL", Zone: "Europe/Tirane", Abbr: []string{"CEST", "CET"}, CountryName: "Albania", Comments: "", Latitude: 41.3333, Longitude: 19.8333},
+	{CountryCode: "AM", Zone: "Asia/Yerevan", Abbr: []string(nil), CountryName: "Armenia", Comments: "", Latitude: 40.1833, Longitude: 44.5},
+	{CountryCode: "AO", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Angola", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "AQ", Zone: "Antarctica/Casey", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Casey", Latitude: -66.2833, Longitude: 110.5167},
+	{CountryCode: "AQ", Zone: "Antarctica/Davis", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Davis", Latitude: -68.5833, Longitude: 77.9667},
+	{CountryCode: "AQ", Zone: "Antarctica/Mawson", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Mawson", Latitude: -67.6, Longitude: 62.8833},
+	{CountryCode: "AQ", Zone: "Antarctica/Palmer", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Palmer", Latitude: -64.8, Longitude: -64.1},
+	{CountryCode: "AQ", Zone: "Antarctica/Rothera", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Rothera", Latitude: -67.5667, Longitude: -68.1333},
+	{CountryCode: "AQ", Zone: "Antarctica/Troll", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Troll", Latitude: -72.0114, Longitude: 2.535},
+	{CountryCode: "AQ", Zone: "Antarctica/Vostok", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Vostok", Latitude: -78.4, Longitude: 106.9},
+	{CountryCode: "AQ", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Syowa", Latitude: 24.6333, Longitude: 46.7167},
+	{CountryCode: "AQ", Zone: "Asia/Singapore", Abbr: []string(nil), CountryName: "Antarctica", Comments: "peninsular Malaysia, Concordia", Latitude: 1.2833, Longitude: 103.85},
+	{CountryCode: "AQ", Zone: "Pacific/Auckland", Abbr: []string{"NZDT", "NZST"}, CountryName: "Antarctica", Comments: "New Zealand time", Latitude: -36.8667, Longitude: 174.7667},
+	{CountryCode: "AQ", Zone: "Pacific/Port_Moresby", Abbr: []string(nil), CountryName: "Antarctica", Comments: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d'Urville", Latitude: -9.5, Longitude: 147.1667},
+	{CountryCode: "AR", Zone: "America/Argentina/Buenos_Aires", Abbr: []string(nil), CountryName: "Argentina", Comments: "Buenos Aires (BA, CF)", Latitude: -34.6, Longitude: -58.45},
+	{CountryCode: "AR", Zone: "America/Argentina/Catamarca", Abbr: []string(nil), CountryName: "Argentina", Comments: "Catamarca (CT), Chubut (CH)", Latitude: -28.4667, Longitude: -65.7833},
+	{CountryCode: "AR", Zone: "America/Argentina/Cordoba", Abbr: []string(nil), CountryName: "Argentina", Comments: "most areas: CB, CC, CN, ER, FM, MN, SE, SF", Latitude: -31.4, Longitude: -64.1833},
+	{CountryCode: "AR", Zone: "America/Argentina/Jujuy", Abbr: []string(nil), CountryName: "Argentina", Comments: "Jujuy (JY)", Latitude: -24.1833, Longitude: -65.3},
+	{CountryCode: "AR", Zone: "America/Argentina/La_Rioja", Abbr: []string(nil), CountryName: "Argentina", Comments: "La Rioja (LR)", Latitude: -29.4333, Longitude: -66.85},
+	{CountryCode: "AR", Zone: "America/Argentina/Mendoza", Abbr: []string(nil), CountryName: "Argentina", Comments: "Mendoza (MZ)", Latitude: -32.8833, Longitude: -68.8167},
+	{CountryCode: "AR", Zone: "America/Argentina/Rio_Gallegos", Abbr: []string(nil), CountryName: "Argentina", Comments: "Santa Cruz (SC)", Latitude: -51.6333, Longitude: -69.2167},
+	{CountryCode: "AR", Zone: "America/Argentina/Salta", Abbr: []string(nil), CountryName: "Argentina", Comments: "Salta (SA, LP, NQ, RN)", Latitude: -24.7833, Longitude: -65.4167},
+	{CountryCode: "AR", Zone: "America/Argentina/San_Juan", Abbr: []string(nil), CountryName: "Argentina", Comments: "San Juan (SJ)", Latitude: -31.5333, Longitude: -68.5167},
+	{CountryCode: "AR", Zone: "America/Argentina/San_Luis", Abbr: []string(nil), CountryName: "Argentina", Comments: "San Luis (SL)", Latitude: -33.3167, Longitude: -66.35},
+	{CountryCode: "AR", Zone: "America/Argentina/Tucuman", Abbr: []string(nil), CountryName: "Argentina", Comments: "Tucumán (TM)", Latitude: -26.8167, Longitude: -65.2167},
+	{CountryCode: "AR", Zone: "America/Argentina/Ushuaia", Abbr: []string(nil), CountryName: "Argentina", Comments: "Tierra del Fuego (TF)", Latitude: -54.8, Longitude: -68.3},
+	{CountryCode: "AS", Zone: "Pacific/Pago_Pago", Abbr: []string{"SST"}, CountryName: "Samoa (American)", Comments: "Midway", Latitude: -14.2667, Longitude: -170.7},
+	{CountryCode: "AT", Zone: "Europe/Vienna", Abbr: []string{"CEST", "CET"}, CountryName: "Austria", Comments: "", Latitude: 48.2167, Longitude: 16.3333},
+	{CountryCode: "AU", Zone: "Antarctica/Macquarie", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "Macquarie Island", Latitude: -54.5, Longitude: 158.95},
+	{CountryCode: "AU", Zone: "Asia/Tokyo", Abbr: []string{"JST"}, CountryName: "Australia", Comments: "Eyre Bird Observatory", Latitude: 35.6544, Longitude: 139.7447},
+	{CountryCode: "AU", Zone: "Australia/Adelaide", Abbr: []string{"ACDT", "ACST"}, CountryName: "Australia", Comments: "South Australia", Latitude: -34.9167, Longitude: 138.5833},
+	{CountryCode: "AU", Zone: "Australia/Brisbane", Abbr: []string{"AEST"}, CountryName: "Australia", Comments: "Queensland (most areas)", Latitude: -27.4667, Longitude: 153.0333},
+	{CountryCode: "AU", Zone: "Australia/Broken_Hill", Abbr: []string{"ACDT", "ACST"}, CountryName: "Australia", Comments: "New South Wales (Yancowinna)", Latitude: -31.95, Longitude: 141.45},
+	{CountryCode: "AU", Zone: "Australia/Darwin", Abbr: []string{"ACST"}, CountryName: "Australia", Comments: "Northern Territory", Latitude: -12.4667, Longitude: 130.8333},
+	{CountryCode: "AU", Zone: "Australia/Eucla", Abbr: []string(nil), CountryName: "Australia", Comments: "Western Australia (Eucla)", Latitude: -31.7167, Longitude: 128.8667},
+	{CountryCode: "AU", Zone: "Australia/Hobart", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "Tasmania", Latitude: -42.8833, Longitude: 147.3167},
+	{CountryCode: "AU", Zone: "Australia/Lindeman", Abbr: []string{"AEST"}, CountryName: "Australia", Comments: "Queensland (Whitsunday Islands)", Latitude: -20.2667, Longitude: 149},
+	{CountryCode: "AU", Zone: "Australia/Lord_Howe", Abbr: []string(nil), CountryName: "Australia", Comments: "Lord Howe Island", Latitude: -31.55, Longitude: 159.0833},
+	{CountryCode: "AU", Zone: "Australia/Melbourne", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "Victoria", Latitude: -37.8167, Longitude: 144.9667},
+	{CountryCode: "AU", Zone: "Australia/Perth", Abbr: []string{"AWST"}, CountryName: "Australia", Comments: "Western Australia (most areas)", Latitude: -31.95, Longitude: 115.85},
+	{CountryCode: "AU", Zone: "Australia/Sydney", Abbr: []string{"AEDT", "AEST"}, CountryName: "Australia", Comments: "New South Wales (most areas)", Latitude: -33.8667, Longitude: 151.2167},
+	{CountryCode: "AW", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Aruba", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "AX", Zone: "Europe/Helsinki", Abbr: []string{"EEST", "EET"}, CountryName: "Åland Islands", Comments: "", Latitude: 60.1667, Longitude: 24.9667},
+	{CountryCode: "AZ", Zone: "Asia/Baku", Abbr: []string(nil), CountryName: "Azerbaijan", Comments: "", Latitude: 40.3833, Longitude: 49.85},
+	{CountryCode: "BA", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Bosnia & Herzegovina", Comments: "", Latitude: 44.8333, Longitude: 20.5},
+	{CountryCode: "BB", Zone: "America/Barbados", Abbr: []string{"AST"}, CountryName: "Barbados", Comments: "", Latitude: 13.1, Longitude: -59.6167},
+	{CountryCode: "BD", Zone: "Asia/Dhaka", Abbr: []string(nil), CountryName: "Bangladesh", Comments: "", Latitude: 23.7167, Longitude: 90.4167},
+	{CountryCode: "BE", Zone: "Europe/Brussels", Abbr: []string{"CEST", "CET"}, CountryName: "Belgium", Comments: "", Latitude: 50.8333, Longitude: 4.3333},
+	{CountryCode: "BF", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Burkina Faso", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "BG", Zone: "Europe/Sofia", Abbr: []string{"EEST", "EET"}, CountryName: "Bulgaria", Comments: "", Latitude: 42.6833, Longitude: 23.3167},
+	{CountryCode: "BH", Zone: "Asia/Qatar", Abbr: []string(nil), CountryName: "Bahrain", Comments: "", Latitude: 25.2833, Longitude: 51.5333},
+	{CountryCode: "BI", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Burundi", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "BJ", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Benin", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "BL", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Barthelemy", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "BM", Zone: "Atlantic/Bermuda", Abbr: []string{"ADT", "AST"}, CountryName: "Bermuda", Comments: "", Latitude: 32.2833, Longitude: -64.7667},
+	{CountryCode: "BN", Zone: "Asia/Kuching", Abbr: []string(nil), CountryName: "Brunei", Comments: "Sabah, Sarawak", Latitude: 1.55, Longitude: 110.3333},
+	{CountryCode: "BO", Zone: "America/La_Paz", Abbr: []string(nil), CountryName: "Bolivia", Comments: "", Latitude: -16.5, Longitude: -68.15},
+	{CountryCode: "BQ", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Caribbean NL", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "BR", Zone: "America/Araguaina", Abbr: []string(nil), CountryName: "Brazil", Comments: "Tocantins", Latitude: -7.2, Longitude: -48.2},
+	{CountryCode: "BR", Zone: "America/Bahia", Abbr: []string(nil), CountryName: "Brazil", Comments: "Bahia", Latitude: -12.9833, Longitude: -38.5167},
+	{CountryCode: "BR", Zone: "America/Belem", Abbr: []string(nil), CountryName: "Brazil", Comments: "Pará (east), Amapá", Latitude: -1.45, Longitude: -48.4833},
+	{CountryCode: "BR", Zone: "America/Boa_Vista", Abbr: []string(nil), CountryName: "Brazil", Comments: "Roraima", Latitude: 2.8167, Longitude: -60.6667},
+	{CountryCode: "BR", Zone: "America/Campo_Grande", Abbr: []string(nil), CountryName: "Brazil", Comments: "Mato Grosso do Sul", Latitude: -20.45, Longitude: -54.6167},
+	{CountryCode: "BR", Zone: "America/Cuiaba", Abbr: []string(nil), CountryName: "Brazil", Comments: "Mato Grosso", Latitude: -15.5833, Longitude: -56.0833},
+	{CountryCode: "BR", Zone: "America/Eirunepe", Abbr: []string(nil), CountryName: "Brazil", Comments: "Amazonas (west)", Latitude: -6.6667, Longitude: -69.8667},
+	{CountryCode: "BR", Zone: "America/Fortaleza", Abbr: []string(nil), CountryName: "Brazil", Comments: "Brazil (northeast: MA, PI, CE, RN, PB)", Latitude: -3.7167, Longitude: -38.5},
+	{CountryCode: "BR", Zone: "America/Maceio", Abbr: []string(nil), CountryName: "Brazil", Comments: "Alagoas, Sergipe", Latitude: -9.6667, Longitude: -35.7167},
+	{CountryCode: "BR", Zone: "America/Manaus", Abbr: []string(nil), CountryName: "Brazil", Comments: "Amazonas (east)", Latitude: -3.1333, Longitude: -60.0167},
+	{CountryCode: "BR", Zone: "America/Noronha", Abbr: []string(nil), CountryName: "Brazil", Comments: "Atlantic islands", Latitude: -3.85, Longitude: -32.4167},
+	{CountryCode: "BR", Zone: "America/Porto_Velho", Abbr: []string(nil), CountryName: "Brazil", Comments: "Rondônia", Latitude: -8.7667, Longitude: -63.9},
+	{CountryCode: "BR", Zone: "America/Recife", Abbr: []string(nil), CountryName: "Brazil", Comments: "Pernambuco", Latitude: -8.05, Longitude: -34.9},
+	{CountryCode: "BR", Zone: "America/Rio_Branco", Abbr: []string(nil), CountryName: "Brazil", Comments: "Acre", Latitude: -9.9667, Longitude: -67.8},
+	{CountryCode: "BR", Zone: "America/Santarem", Abbr: []string(nil), CountryName: "Brazil", Comments: "Pará (west)", Latitude: -2.4333, Longitude: -54.8667},
+	{CountryCode: "BR", Zone: "America/Sao_Paulo", Abbr: []string(nil), CountryName: "Brazil", Comments: "Brazil (southeast: GO, DF, MG, ES, RJ, SP, PR, SC, RS)", Latitude: -23.5333, Longitude: -46.6167},
+	{CountryCode: "BS", Zone: "America/Toronto", Abbr: []string{"EDT", "EST"}, CountryName: "Bahamas", Comments: "Eastern - ON & QC (most areas)", Latitude: 43.65, Longitude: -79.3833},
+	{CountryCode: "BT", Zone: "Asia/Thimphu", Abbr: []string(nil), CountryName: "Bhutan", Comments: "", Latitude: 27.4667, Longitude: 89.65},
+	{CountryCode: "BW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Botswana", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "BY", Zone: "Europe/Minsk", Abbr: []string(nil), CountryName: "Belarus", Comments: "", Latitude: 53.9, Longitude: 27.5667},
+	{CountryCode: "BZ", Zone: "America/Belize", Abbr: []string{"CST"}, CountryName: "Belize", Comments: "", Latitude: 17.5, Longitude: -88.2},
+	{CountryCode: "CA", Zone: "America/Cambridge_Bay", Abbr: []string{"MDT", "MST"}, CountryName: "Canada", Comments: "Mountain - NU (west)", Latitude: 69.1139, Longitude: -105.0528},
+	{CountryCode: "CA", Zone: "America/Dawson", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - Yukon (west)", Latitude: 64.0667, Longitude: -139.4167},
+	{CountryCode: "CA", Zone: "America/Dawson_Creek", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - BC (Dawson Cr, Ft St John)", Latitude: 55.7667, Longitude: -120.2333},
+	{CountryCode: "CA", Zone: "America/Edmonton", Abbr: []string{"MDT", "MST"}, CountryName: "Canada", Comments: "Mountain - AB, BC(E), NT(E), SK(W)", Latitude: 53.55, Longitude: -113.4667},
+	{CountryCode: "CA", Zone: "America/Fort_Nelson", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - BC (Ft Nelson)", Latitude: 58.8, Longitude: -122.7},
+	{CountryCode: "CA", Zone: "America/Glace_Bay", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - NS (Cape Breton)", Latitude: 46.2, Longitude: -59.95},
+	{CountryCode: "CA", Zone: "America/Goose_Bay", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - Labrador (most areas)", Latitude: 53.3333, Longitude: -60.4167},
+	{CountryCode: "CA", Zone: "America/Halifax", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - NS (most areas), PE", Latitude: 44.65, Longitude: -63.6},
+	{CountryCode: "CA", Zone: "America/Inuvik", Abbr: []string{"MDT", "MST"}, CountryName: "Canada", Comments: "Mountain - NT (west)", Latitude: 68.3497, Longitude: -133.7167},
+	{CountryCode: "CA", Zone: "America/Iqaluit", Abbr: []string{"EDT", "EST"}, CountryName: "Canada", Comments: "Eastern - NU (most areas)", Latitude: 63.7333, Longitude: -68.4667},
+	{CountryCode: "CA", Zone: "America/Moncton", Abbr: []string{"ADT", "AST"}, CountryName: "Canada", Comments: "Atlantic - New Brunswick", Latitude: 46.1, Longitude: -64.7833},
+	{CountryCode: "CA", Zone: "America/Panama", Abbr: []string{"EST"}, CountryName: "Canada", Comments: "EST - ON (Atikokan), NU (Coral H)", Latitude: 8.9667, Longitude: -79.5333},
+	{CountryCode: "CA", Zone: "America/Phoenix", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - AZ (most areas), Creston BC", Latitude: 33.4483, Longitude: -112.0733},
+	{CountryCode: "CA", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Canada", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "CA", Zone: "America/Rankin_Inlet", Abbr: []string{"CDT", "CST"}, CountryName: "Canada", Comments: "Central - NU (central)", Latitude: 62.8167, Longitude: -92.0831},
+	{CountryCode: "CA", Zone: "America/Regina", Abbr: []string{"CST"}, CountryName: "Canada", Comments: "CST - SK (most areas)", Latitude: 50.4, Longitude: -104.65},
+	{CountryCode: "CA", Zone: "America/Resolute", Abbr: []string{"CDT", "CST"}, CountryName: "Canada", Comments: "Central - NU (Resolute)", Latitude: 74.6956, Longitude: -94.8292},
+	{CountryCode: "CA", Zone: "America/St_Johns", Abbr: []string{"NDT", "NST"}, CountryName: "Canada", Comments: "Newfoundland, Labrador (SE)", Latitude: 47.5667, Longitude: -52.7167},
+	{CountryCode: "CA", Zone: "America/Swift_Current", Abbr: []string{"CST"}, CountryName: "Canada", Comments: "CST - SK (midwest)", Latitude: 50.2833, Longitude: -107.8333},
+	{CountryCode: "CA", Zone: "America/Toronto", Abbr: []string{"EDT", "EST"}, CountryName: "Canada", Comments: "Eastern - ON & QC (most areas)", Latitude: 43.65, Longitude: -79.3833},
+	{CountryCode: "CA", Zone: "America/Vancouver", Abbr: []string{"PDT", "PST"}, CountryName: "Canada", Comments: "Pacific - BC (most areas)", Latitude: 49.2667, Longitude: -123.1167},
+	{CountryCode: "CA", Zone: "America/Whitehorse", Abbr: []string{"MST"}, CountryName: "Canada", Comments: "MST - Yukon (east)", Latitude: 60.7167, Longitude: -135.05},
+	{CountryCode: "CA", Zone: "America/Winnipeg", Abbr: []string{"CDT", "CST"}, CountryName: "Canada", Comments: "Central - ON (west), Manitoba", Latitude: 49.8833, Longitude: -97.15},
+	{CountryCode: "CC", Zone: "Asia/Yangon", Abbr: []string(nil), CountryName: "Cocos (Keeling) Islands", Comments: "", Latitude: 16.7833, Longitude: 96.1667},
+	{CountryCode: "CD", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Congo (Dem. Rep.)", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "CD", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Congo (Dem. Rep.)", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "CF", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Central African Rep.", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "CG", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Congo (Rep.)", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "CH", Zone: "Europe/Zurich", Abbr: []string{"CEST", "CET"}, CountryName: "Switzerland", Comments: "Büsingen", Latitude: 47.3833, Longitude: 8.5333},
+	{CountryCode: "CI", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Côte d'Ivoire", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "CK", Zone: "Pacific/Rarotonga", Abbr: []string(nil), CountryName: "Cook Islands", Comments: "", Latitude: -21.2333, Longitude: -159.7667},
+	{CountryCode: "CL", Zone: "America/Coyhaique", Abbr: []string(nil), CountryName: "Chile", Comments: "Aysén Region", Latitude: -45.5667, Longitude: -72.0667},
+	{CountryCode: "CL", Zone: "America/Punta_Arenas", Abbr: []string(nil), CountryName: "Chile", Comments: "Magallanes Region", Latitude: -53.15, Longitude: -70.9167},
+	{CountryCode: "CL", Zone: "America/Santiago", Abbr: []string(nil), CountryName: "Chile", Comments: "most of Chile", Latitude: -33.45, Longitude: -70.6667},
+	{CountryCode: "CL", Zone: "Pacific/Easter", Abbr: []string(nil), CountryName: "Chile", Comments: "Easter Island", Latitude: -27.15, Longitude: -109.4333},
+	{CountryCode: "CM", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Cameroon", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "CN", Zone: "Asia/Shanghai", Abbr: []string{"CST"}, CountryName: "China", Comments: "Beijing Time", Latitude: 31.2333, Longitude: 121.4667},
+	{CountryCode: "CN", Zone: "Asia/Urumqi", Abbr: []string(nil), CountryName: "China", Comments: "Xinjiang Time", Latitude: 43.8, Longitude: 87.5833},
+	{CountryCode: "CO", Zone: "America/Bogota", Abbr: []string(nil), CountryName: "Colombia", Comments: "", Latitude: 4.6, Longitude: -74.0833},
+	{CountryCode: "CR", Zone: "America/Costa_Rica", Abbr: []string{"CST"}, CountryName: "Costa Rica", Comments: "", Latitude: 9.9333, Longitude: -84.0833},
+	{CountryCode: "CU", Zone: "America/Havana", Abbr: []string{"CDT", "CST"}, CountryName: "Cuba", Comments: "", Latitude: 23.1333, Longitude: -82.3667},
+	{CountryCode: "CV", Zone: "Atlantic/Cape_Verde", Abbr: []string(nil), CountryName: "Cape Verde", Comments: "", Latitude: 14.9167, Longitude: -23.5167},
+	{CountryCode: "CW", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Curaçao", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "CX", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Christmas Island", Comments: "north Vietnam", Latitude: 13.75, Longitude: 100.5167},
+	{CountryCode: "CY", Zone: "Asia/Famagusta", Abbr: []string{"EEST", "EET"}, CountryName: "Cyprus", Comments: "Northern Cyprus", Latitude: 35.1167, Longitude: 33.95},
+	{CountryCode: "CY", Zone: "Asia/Nicosia", Abbr: []string{"EEST", "EET"}, CountryName: "Cyprus", Comments: "most of Cyprus", Latitude: 35.1667, Longitude: 33.3667},
+	{CountryCode: "CZ", Zone: "Europe/Prague", Abbr: []string{"CEST", "CET"}, CountryName: "Czech Republic", Comments: "", Latitude: 50.0833, Longitude: 14.4333},
+	{CountryCode: "DE", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Germany", Comments: "most of Germany", Latitude: 52.5, Longitude: 13.3667},
+	{CountryCode: "DE", Zone: "Europe/Zurich", Abbr: []string{"CEST", "CET"}, CountryName: "Germany", Comments: "Büsingen", Latitude: 47.3833, Longitude: 8.5333},
+	{CountryCode: "DJ", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Djibouti", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "DK", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Denmark", Comments: "most of Germany", Latitude: 52.5, Longitude: 13.3667},
+	{CountryCode: "DM", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Dominica", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "DO", Zone: "America/Santo_Domingo", Abbr: []string{"AST"}, CountryName: "Dominican Republic", Comments: "", Latitude: 18.4667, Longitude: -69.9},
+	{CountryCode: "DZ", Zone: "Africa/Algiers", Abbr: []string{"CET"}, CountryName: "Algeria", Comments: "", Latitude: 36.7833, Longitude: 3.05},
+	{CountryCode: "EC", Zone: "America/Guayaquil", Abbr: []string(nil), CountryName: "Ecuador", Comments: "Ecuador (mainland)", Latitude: -2.1667, Longitude: -79.8333},
+	{CountryCode: "EC", Zone: "Pacific/Galapagos", Abbr: []string(nil), CountryName: "Ecuador", Comments: "Galápagos Islands", Latitude: -0.9, Longitude: -89.6},
+	{CountryCode: "EE", Zone: "Europe/Tallinn", Abbr: []string{"EEST", "EET"}, CountryName: "Estonia", Comments: "", Latitude: 59.4167, Longitude: 24.75},
+	{CountryCode: "EG", Zone: "Africa/Cairo", Abbr: []string{"EEST", "EET"}, CountryName: "Egypt", Comments: "", Latitude: 30.05, Longitude: 31.25},
+	{CountryCode: "EH", Zone: "Africa/El_Aaiun", Abbr: []string(nil), CountryName: "Western Sahara", Comments: "", Latitude: 27.15, Longitude: -13.2},
+	{CountryCode: "ER", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Eritrea", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "ES", Zone: "Africa/Ceuta", Abbr: []string{"CEST", "CET"}, CountryName: "Spain", Comments: "Ceuta, Melilla", Latitude: 35.8833, Longitude: -5.3167},
+	{CountryCode: "ES", Zone: "Atlantic/Canary", Abbr: []string{"WEST", "WET"}, CountryName: "Spain", Comments: "Canary Islands", Latitude: 28.1, Longitude: -15.4},
+	{CountryCode: "ES", Zone: "Europe/Madrid", Abbr: []string{"CEST", "CET"}, CountryName: "Spain", Comments: "Spain (mainland)", Latitude: 40.4, Longitude: -3.6833},
+	{CountryCode: "ET", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Ethiopia", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "FI", Zone: "Europe/Helsinki", Abbr: []string{"EEST", "EET"}, CountryName: "Finland", Comments: "", Latitude: 60.1667, Longitude: 24.9667},
+	{CountryCode: "FJ", Zone: "Pacific/Fiji", Abbr: []string(nil), CountryName: "Fiji", Comments: "", Latitude: -18.1333, Longitude: 178.4167},
+	{CountryCode: "FK", Zone: "Atlantic/Stanley", Abbr: []string(nil), CountryName: "Falkland Islands", Comments: "", Latitude: -51.7, Longitude: -57.85},
+	{CountryCode: "FM", Zone: "Pacific/Guadalcanal", Abbr: []string(nil), CountryName: "Micronesia", Comments: "Pohnpei", Latitude: -9.5333, Longitude: 160.2},
+	{CountryCode: "FM", Zone: "Pacific/Kosrae", Abbr: []string(nil), CountryName: "Micronesia", Comments: "Kosrae", Latitude: 5.3167, Longitude: 162.9833},
+	{CountryCode: "FM", Zone: "Pacific/Port_Moresby", Abbr: []string(nil), CountryName: "Micronesia", Comments: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d'Urville", Latitude: -9.5, Longitude: 147.1667},
+	{CountryCode: "FO", Zone: "Atlantic/Faroe", Abbr: []string{"WEST", "WET"}, CountryName: "Faroe Islands", Comments: "", Latitude: 62.0167, Longitude: -6.7667},
+	{CountryCode: "FR", Zone: "Europe/Paris", Abbr: []string{"CEST", "CET"}, CountryName: "France", Comments: "", Latitude: 48.8667, Longitude: 2.3333},
+	{CountryCode: "GA", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Gabon", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "GB", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Britain (UK)", Comments: "", Latitude: 51.5083, Longitude: -0.1253},
+	{CountryCode: "GD", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Grenada", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "GE", Zone: "Asia/Tbilisi", Abbr: []string(nil), CountryName: "Georgia", Comments: "", Latitude: 41.7167, Longitude: 44.8167},
+	{CountryCode: "GF", Zone: "America/Cayenne", Abbr: []string(nil), CountryName: "French Guiana", Comments: "", Latitude: 4.9333, Longitude: -52.3333},
+	{CountryCode: "GG", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Guernsey", Comments: "", Latitude: 51.5083, Longitude: -0.1253},
+	{CountryCode: "GH", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Ghana", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "GI", Zone: "Europe/Gibraltar", Abbr: []string{"CEST", "CET"}, CountryName: "Gibraltar", Comments: "", Latitude: 36.1333, Longitude: -5.35},
+	{CountryCode: "GL", Zone: "America/Danmarkshavn", Abbr: []string{"GMT"}, CountryName: "Greenland", Comments: "National Park (east coast)", Latitude: 76.7667, Longitude: -18.6667},
+	{CountryCode: "GL", Zone: "America/Nuuk", Abbr: []string(nil), CountryName: "Greenland", Comments: "most of Greenland", Latitude: 64.1833, Longitude: -51.7333},
+	{CountryCode: "GL", Zone: "America/Scoresbysund", Abbr: []string(nil), CountryName: "Greenland", Comments: "Scoresbysund/Ittoqqortoormiit", Latitude: 70.4833, Longitude: -21.9667},
+	{CountryCode: "GL", Zone: "America/Thule", Abbr: []string{"ADT", "AST"}, CountryName: "Greenland", Comments: "Thule/Pituffik", Latitude: 76.5667, Longitude: -68.7833},
+	{CountryCode: "GM", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Gambia", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "GN", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Guinea", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "GP", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Guadeloupe", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "GQ", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Equatorial Guinea", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "GR", Zone: "Europe/Athens", Abbr: []string{"EEST", "EET"}, CountryName: "Greece", Comments: "", Latitude: 37.9667, Longitude: 23.7167},
+	{CountryCode: "GS", Zone: "Atlantic/South_Georgia", Abbr: []string(nil), CountryName: "South Georgia & the South Sandwich Islands", Comments: "", Latitude: -54.2667, Longitude: -36.5333},
+	{CountryCode: "GT", Zone: "America/Guatemala", Abbr: []string{"CST"}, CountryName: "Guatemala", Comments: "", Latitude: 14.6333, Longitude: -90.5167},
+	{CountryCode: "GU", Zone: "Pacific/Guam", Abbr: []string{"ChST"}, CountryName: "Guam", Comments: "", Latitude: 13.4667, Longitude: 144.75},
+	{CountryCode: "GW", Zone: "Africa/Bissau", Abbr: []string{"GMT"}, CountryName: "Guinea-Bissau", Comments: "", Latitude: 11.85, Longitude: -15.5833},
+	{CountryCode: "GY", Zone: "America/Guyana", Abbr: []string(nil), CountryName: "Guyana", Comments: "", Latitude: 6.8, Longitude: -58.1667},
+	{CountryCode: "HK", Zone: "Asia/Hong_Kong", Abbr: []string{"HKT"}, CountryName: "Hong Kong", Comments: "", Latitude: 22.2833, Longitude: 114.15},
+	{CountryCode: "HN", Zone: "America/Tegucigalpa", Abbr: []string{"CST"}, CountryName: "Honduras", Comments: "", Latitude: 14.1, Longitude: -87.2167},
+	{CountryCode: "HR", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Croatia", Comments: "", Latitude: 44.8333, Longitude: 20.5},
+	{CountryCode: "HT", Zone: "America/Port-au-Prince", Abbr: []string{"EDT", "EST"}, CountryName: "Haiti", Comments: "", Latitude: 18.5333, Longitude: -72.3333},
+	{CountryCode: "HU", Zone: "Europe/Budapest", Abbr: []string{"CEST", "CET"}, CountryName: "Hungary", Comments: "", Latitude: 47.5, Longitude: 19.0833},
+	{CountryCode: "ID", Zone: "Asia/Jakarta", Abbr: []string{"WIB"}, CountryName: "Indonesia", Comments: "Java, Sumatra", Latitude: -6.1667, Longitude: 106.8},
+	{CountryCode: "ID", Zone: "Asia/Jayapura", Abbr: []string{"WIT"}, CountryName: "Indonesia", Comments: "New Guinea (West Papua / Irian Jaya), Malukus/Moluccas", Latitude: -2.5333, Longitude: 140.7},
+	{CountryCode: "ID", Zone: "Asia/Makassar", Abbr: []string{"WITA"}, CountryName: "Indonesia", Comments: "Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)", Latitude: -5.1167, Longitude: 119.4},
+	{CountryCode: "ID", Zone: "Asia/Pontianak", Abbr: []string{"WIB"}, CountryName: "Indonesia", Comments: "Borneo (west, central)", Latitude: -0.0333, Longitude: 109.3333},
+	{CountryCode: "IE", Zone: "Europe/Dublin", Abbr: []string{"GMT", "IST"}, CountryName: "Ireland", Comments: "", Latitude: 53.3333, Longitude: -6.25},
+	{CountryCode: "IL", Zone: "Asia/Jerusalem", Abbr: []string{"IDT", "IST"}, CountryName: "Israel", Comments: "", Latitude: 31.7806, Longitude: 35.2239},
+	{CountryCode: "IM", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Isle of Man", Comments: "", Latitude: 51.5083, Longitude: -0.1253},
+	{CountryCode: "IN", Zone: "Asia/Kolkata", Abbr: []string{"IST"}, CountryName: "India", Comments: "", Latitude: 22.5333, Longitude: 88.3667},
+	{CountryCode: "IO", Zone: "Indian/Chagos", Abbr: []string(nil), CountryName: "British Indian Ocean Territory", Comments: "", Latitude: -7.3333, Longitude: 72.4167},
+	{CountryCode: "IQ", Zone: "Asia/Baghdad", Abbr: []string(nil), CountryName: "Iraq", Comments: "", Latitude: 33.35, Longitude: 44.4167},
+	{CountryCode: "IR", Zone: "Asia/Tehran", Abbr: []string(nil), CountryName: "Iran", Comments: "", Latitude: 35.6667, Longitude: 51.4333},
+	{CountryCode: "IS", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Iceland", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "IT", Zone: "Europe/Rome", Abbr: []string{"CEST", "CET"}, CountryName: "Italy", Comments: "", Latitude: 41.9, Longitude: 12.4833},
+	{CountryCode: "JE", Zone: "Europe/London", Abbr: []string{"BST", "GMT"}, CountryName: "Jersey", Comments: "", Latitude: 51.5083, Longitude: -0.1253},
+	{CountryCode: "JM", Zone: "America/Jamaica", Abbr: []string{"EST"}, CountryName: "Jamaica", Comments: "", Latitude: 17.9681, Longitude: -76.7933},
+	{CountryCode: "JO", Zone: "Asia/Amman", Abbr: []string(nil), CountryName: "Jordan", Comments: "", Latitude: 31.95, Longitude: 35.9333},
+	{CountryCode: "JP", Zone: "Asia/Tokyo", Abbr: []string{"JST"}, CountryName: "Japan", Comments: "Eyre Bird Observatory", Latitude: 35.6544, Longitude: 139.7447},
+	{CountryCode: "KE", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Kenya", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "KG", Zone: "Asia/Bishkek", Abbr: []string(nil), CountryName: "Kyrgyzstan", Comments: "", Latitude: 42.9, Longitude: 74.6},
+	{CountryCode: "KH", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Cambodia", Comments: "north Vietnam", Latitude: 13.75, Longitude: 100.5167},
+	{CountryCode: "KI", Zone: "Pacific/Kanton", Abbr: []string(nil), CountryName: "Kiribati", Comments: "Phoenix Islands", Latitude: -2.7833, Longitude: -171.7167},
+	{CountryCode: "KI", Zone: "Pacific/Kiritimati", Abbr: []string(nil), CountryName: "Kiribati", Comments: "Line Islands", Latitude: 1.8667, Longitude: -157.3333},
+	{CountryCode: "KI", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Kiribati", Comments: "Gilberts, Marshalls, Wake", Latitude: 1.4167, Longitude: 173},
+	{CountryCode: "KM", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Comoros", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "KN", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Kitts & Nevis", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "KP", Zone: "Asia/Pyongyang", Abbr: []string{"KST"}, CountryName: "Korea (North)", Comments: "", Latitude: 39.0167, Longitude: 125.75},
+	{CountryCode: "KR", Zone: "Asia/Seoul", Abbr: []string{"KST"}, CountryName: "Korea (South)", Comments: "", Latitude: 37.55, Longitude: 126.9667},
+	{CountryCode: "KW", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Kuwait", Comments: "Syowa", Latitude: 24.6333, Longitude: 46.7167},
+	{CountryCode: "KY", Zone: "America/Panama", Abbr: []string{"EST"}, CountryName: "Cayman Islands", Comments: "EST - ON (Atikokan), NU (Coral H)", Latitude: 8.9667, Longitude: -79.5333},
+	{CountryCode: "KZ", Zone: "Asia/Almaty", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "most of Kazakhstan", Latitude: 43.25, Longitude: 76.95},
+	{CountryCode: "KZ", Zone: "Asia/Aqtau", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Mangghystaū/Mankistau", Latitude: 44.5167, Longitude: 50.2667},
+	{CountryCode: "KZ", Zone: "Asia/Aqtobe", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Aqtöbe/Aktobe", Latitude: 50.2833, Longitude: 57.1667},
+	{CountryCode: "KZ", Zone: "Asia/Atyrau", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Atyraū/Atirau/Gur'yev", Latitude: 47.1167, Longitude: 51.9333},
+	{CountryCode: "KZ", Zone: "Asia/Oral", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "West Kazakhstan", Latitude: 51.2167, Longitude: 51.35},
+	{CountryCode: "KZ", Zone: "Asia/Qostanay", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Qostanay/Kostanay/Kustanay", Latitude: 53.2, Longitude: 63.6167},
+	{CountryCode: "KZ", Zone: "Asia/Qyzylorda", Abbr: []string(nil), CountryName: "Kazakhstan", Comments: "Qyzylorda/Kyzylorda/Kzyl-Orda", Latitude: 44.8, Longitude: 65.4667},
+	{CountryCode: "LA", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Laos", Comments: "north Vietnam", Latitude: 13.75, Longitude: 100.5167},
+	{CountryCode: "LB", Zone: "Asia/Beirut", Abbr: []string{"EEST", "EET"}, CountryName: "Lebanon", Comments: "", Latitude: 33.8833, Longitude: 35.5},
+	{CountryCode: "LC", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Lucia", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "LI", Zone: "Europe/Zurich", Abbr: []string{"CEST", "CET"}, CountryName: "Liechtenstein", Comments: "Büsingen", Latitude: 47.3833, Longitude: 8.5333},
+	{CountryCode: "LK", Zone: "Asia/Colombo", Abbr: []string(nil), CountryName: "Sri Lanka", Comments: "", Latitude: 6.9333, Longitude: 79.85},
+	{CountryCode: "LR", Zone: "Africa/Monrovia", Abbr: []string{"GMT"}, CountryName: "Liberia", Comments: "", Latitude: 6.3, Longitude: -10.7833},
+	{CountryCode: "LS", Zone: "Africa/Johannesburg", Abbr: []string{"SAST"}, CountryName: "Lesotho", Comments: "", Latitude: -26.25, Longitude: 28},
+	{CountryCode: "LT", Zone: "Europe/Vilnius", Abbr: []string{"EEST", "EET"}, CountryName: "Lithuania", Comments: "", Latitude: 54.6833, Longitude: 25.3167},
+	{CountryCode: "LU", Zone: "Europe/Brussels", Abbr: []string{"CEST", "CET"}, CountryName: "Luxembourg", Comments: "", Latitude: 50.8333, Longitude: 4.3333},
+	{CountryCode: "LV", Zone: "Europe/Riga", Abbr: []string{"EEST", "EET"}, CountryName: "Latvia", Comments: "", Latitude: 56.95, Longitude: 24.1},
+	{CountryCode: "LY", Zone: "Africa/Tripoli", Abbr: []string{"EET"}, CountryName: "Libya", Comments: "", Latitude: 32.9, Longitude: 13.1833},
+	{CountryCode: "MA", Zone: "Africa/Casablanca", Abbr: []string(nil), CountryName: "Morocco", Comments: "", Latitude: 33.65, Longitude: -7.5833},
+	{CountryCode: "MC", Zone: "Europe/Paris", Abbr: []string{"CEST", "CET"}, CountryName: "Monaco", Comments: "", Latitude: 48.8667, Longitude: 2.3333},
+	{CountryCode: "MD", Zone: "Europe/Chisinau", Abbr: []string{"EEST", "EET"}, CountryName: "Moldova", Comments: "", Latitude: 47, Longitude: 28.8333},
+	{CountryCode: "ME", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Montenegro", Comments: "", Latitude: 44.8333, Longitude: 20.5},
+	{CountryCode: "MF", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Martin (French)", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "MG", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Madagascar", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "MH", Zone: "Pacific/Kwajalein", Abbr: []string(nil), CountryName: "Marshall Islands", Comments: "Kwajalein", Latitude: 9.0833, Longitude: 167.3333},
+	{CountryCode: "MH", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Marshall Islands", Comments: "Gilberts, Marshalls, Wake", Latitude: 1.4167, Longitude: 173},
+	{CountryCode: "MK", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "North Macedonia", Comments: "", Latitude: 44.8333, Longitude: 20.5},
+	{CountryCode: "ML", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Mali", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "MM", Zone: "Asia/Yangon", Abbr: []string(nil), CountryName: "Myanmar (Burma)", Comments: "", Latitude: 16.7833, Longitude: 96.1667},
+	{CountryCode: "MN", Zone: "Asia/Hovd", Abbr: []string(nil), CountryName: "Mongolia", Comments: "Bayan-Ölgii, Hovd, Uvs", Latitude: 48.0167, Longitude: 91.65},
+	{CountryCode: "MN", Zone: "Asia/Ulaanbaatar", Abbr: []string(nil), CountryName: "Mongolia", Comments: "most of Mongolia", Latitude: 47.9167, Longitude: 106.8833},
+	{CountryCode: "MO", Zone: "Asia/Macau", Abbr: []string{"CST"}, CountryName: "Macau", Comments: "", Latitude: 22.1972, Longitude: 113.5417},
+	{CountryCode: "MP", Zone: "Pacific/Guam", Abbr: []string{"ChST"}, CountryName: "Northern Mariana Islands", Comments: "", Latitude: 13.4667, Longitude: 144.75},
+	{CountryCode: "MQ", Zone: "America/Martinique", Abbr: []string{"AST"}, CountryName: "Martinique", Comments: "", Latitude: 14.6, Longitude: -61.0833},
+	{CountryCode: "MR", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Mauritania", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "MS", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Montserrat", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "MT", Zone: "Europe/Malta", Abbr: []string{"CEST", "CET"}, CountryName: "Malta", Comments: "", Latitude: 35.9, Longitude: 14.5167},
+	{CountryCode: "MU", Zone: "Indian/Mauritius", Abbr: []string(nil), CountryName: "Mauritius", Comments: "", Latitude: -20.1667, Longitude: 57.5},
+	{CountryCode: "MV", Zone: "Indian/Maldives", Abbr: []string(nil), CountryName: "Maldives", Comments: "Kerguelen, St Paul I, Amsterdam I", Latitude: 4.1667, Longitude: 73.5},
+	{CountryCode: "MW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Malawi", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "MX", Zone: "America/Bahia_Banderas", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Bahía de Banderas", Latitude: 20.8, Longitude: -105.25},
+	{CountryCode: "MX", Zone: "America/Cancun", Abbr: []string{"EST"}, CountryName: "Mexico", Comments: "Quintana Roo", Latitude: 21.0833, Longitude: -86.7667},
+	{CountryCode: "MX", Zone: "America/Chihuahua", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Chihuahua (most areas)", Latitude: 28.6333, Longitude: -106.0833},
+	{CountryCode: "MX", Zone: "America/Ciudad_Juarez", Abbr: []string{"MDT", "MST"}, CountryName: "Mexico", Comments: "Chihuahua (US border - west)", Latitude: 31.7333, Longitude: -106.4833},
+	{CountryCode: "MX", Zone: "America/Hermosillo", Abbr: []string{"MST"}, CountryName: "Mexico", Comments: "Sonora", Latitude: 29.0667, Longitude: -110.9667},
+	{CountryCode: "MX", Zone: "America/Matamoros", Abbr: []string{"CDT", "CST"}, CountryName: "Mexico", Comments: "Coahuila, Nuevo León, Tamaulipas (US border)", Latitude: 25.8333, Longitude: -97.5},
+	{CountryCode: "MX", Zone: "America/Mazatlan", Abbr: []string{"MST"}, CountryName: "Mexico", Comments: "Baja California Sur, Nayarit (most areas), Sinaloa", Latitude: 23.2167, Longitude: -106.4167},
+	{CountryCode: "MX", Zone: "America/Merida", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Campeche, Yucatán", Latitude: 20.9667, Longitude: -89.6167},
+	{CountryCode: "MX", Zone: "America/Mexico_City", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Central Mexico", Latitude: 19.4, Longitude: -99.15},
+	{CountryCode: "MX", Zone: "America/Monterrey", Abbr: []string{"CST"}, CountryName: "Mexico", Comments: "Durango; Coahuila, Nuevo León, Tamaulipas (most areas)", Latitude: 25.6667, Longitude: -100.3167},
+	{CountryCode: "MX", Zone: "America/Ojinaga", Abbr: []string{"CDT", "CST"}, CountryName: "Mexico", Comments: "Chihuahua (US border - east)", Latitude: 29.5667, Longitude: -104.4167},
+	{CountryCode: "MX", Zone: "America/Tijuana", Abbr: []string{"PDT", "PST"}, CountryName: "Mexico", Comments: "Baja California", Latitude: 32.5333, Longitude: -117.0167},
+	{CountryCode: "MY", Zone: "Asia/Kuching", Abbr: []string(nil), CountryName: "Malaysia", Comments: "Sabah, Sarawak", Latitude: 1.55, Longitude: 110.3333},
+	{CountryCode: "MY", Zone: "Asia/Singapore", Abbr: []string(nil), CountryName: "Malaysia", Comments: "peninsular Malaysia, Concordia", Latitude: 1.2833, Longitude: 103.85},
+	{CountryCode: "MZ", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Mozambique", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "NA", Zone: "Africa/Windhoek", Abbr: []string{"CAT"}, CountryName: "Namibia", Comments: "", Latitude: -22.5667, Longitude: 17.1},
+	{CountryCode: "NC", Zone: "Pacific/Noumea", Abbr: []string(nil), CountryName: "New Caledonia", Comments: "", Latitude: -22.2667, Longitude: 166.45},
+	{CountryCode: "NE", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Niger", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "NF", Zone: "Pacific/Norfolk", Abbr: []string(nil), CountryName: "Norfolk Island", Comments: "", Latitude: -29.05, Longitude: 167.9667},
+	{CountryCode: "NG", Zone: "Africa/Lagos", Abbr: []string{"WAT"}, CountryName: "Nigeria", Comments: "West Africa Time", Latitude: 6.45, Longitude: 3.4},
+	{CountryCode: "NI", Zone: "America/Managua", Abbr: []string{"CST"}, CountryName: "Nicaragua", Comments: "", Latitude: 12.15, Longitude: -86.2833},
+	{CountryCode: "NL", Zone: "Europe/Brussels", Abbr: []string{"CEST", "CET"}, CountryName: "Netherlands", Comments: "", Latitude: 50.8333, Longitude: 4.3333},
+	{CountryCode: "NO", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Norway", Comments: "most of Germany", Latitude: 52.5, Longitude: 13.3667},
+	{CountryCode: "NP", Zone: "Asia/Kathmandu", Abbr: []string(nil), CountryName: "Nepal", Comments: "", Latitude: 27.7167, Longitude: 85.3167},
+	{CountryCode: "NR", Zone: "Pacific/Nauru", Abbr: []string(nil), CountryName: "Nauru", Comments: "", Latitude: -0.5167, Longitude: 166.9167},
+	{CountryCode: "NU", Zone: "Pacific/Niue", Abbr: []string(nil), CountryName: "Niue", Comments: "", Latitude: -19.0167, Longitude: -169.9167},
+	{CountryCode: "NZ", Zone: "Pacific/Auckland", Abbr: []string{"NZDT", "NZST"}, CountryName: "New Zealand", Comments: "New Zealand time", Latitude: -36.8667, Longitude: 174.7667},
+	{CountryCode: "NZ", Zone: "Pacific/Chatham", Abbr: []string(nil), CountryName: "New Zealand", Comments: "Chatham Islands", Latitude: -43.95, Longitude: -176.55},
+	{CountryCode: "OM", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "Oman", Comments: "Crozet", Latitude: 25.3, Longitude: 55.3},
+	{CountryCode: "PA", Zone: "America/Panama", Abbr: []string{"EST"}, CountryName: "Panama", Comments: "EST - ON (Atikokan), NU (Coral H)", Latitude: 8.9667, Longitude: -79.5333},
+	{CountryCode: "PE", Zone: "America/Lima", Abbr: []string(nil), CountryName: "Peru", Comments: "", Latitude: -12.05, Longitude: -77.05},
+	{CountryCode: "PF", Zone: "Pacific/Gambier", Abbr: []string(nil), CountryName: "French Polynesia", Comments: "Gambier Islands", Latitude: -23.1333, Longitude: -134.95},
+	{CountryCode: "PF", Zone: "Pacific/Marquesas", Abbr: []string(nil), CountryName: "French Polynesia", Comments: "Marquesas Islands", Latitude: -9, Longitude: -139.5},
+	{CountryCode: "PF", Zone: "Pacific/Tahiti", Abbr: []string(nil), CountryName: "French Polynesia", Comments: "Society Islands", Latitude: -17.5333, Longitude: -149.5667},
+	{CountryCode: "PG", Zone: "Pacific/Bougainville", Abbr: []string(nil), CountryName: "Papua New Guinea", Comments: "Bougainville", Latitude: -6.2167, Longitude: 155.5667},
+	{CountryCode: "PG", Zone: "Pacific/Port_Moresby", Abbr: []string(nil), CountryName: "Papua New Guinea", Comments: "Papua New Guinea (most areas), Chuuk, Yap, Dumont d'Urville", Latitude: -9.5, Longitude: 147.1667},
+	{CountryCode: "PH", Zone: "Asia/Manila", Abbr: []string{"PST"}, CountryName: "Philippines", Comments: "", Latitude: 14.5867, Longitude: 120.9678},
+	{CountryCode: "PK", Zone: "Asia/Karachi", Abbr: []string{"PKT"}, CountryName: "Pakistan", Comments: "", Latitude: 24.8667, Longitude: 67.05},
+	{CountryCode: "PL", Zone: "Europe/Warsaw", Abbr: []string{"CEST", "CET"}, CountryName: "Poland", Comments: "", Latitude: 52.25, Longitude: 21},
+	{CountryCode: "PM", Zone: "America/Miquelon", Abbr: []string(nil), CountryName: "St Pierre & Miquelon", Comments: "", Latitude: 47.05, Longitude: -56.3333},
+	{CountryCode: "PN", Zone: "Pacific/Pitcairn", Abbr: []string(nil), CountryName: "Pitcairn", Comments: "", Latitude: -25.0667, Longitude: -130.0833},
+	{CountryCode: "PR", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Puerto Rico", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "PS", Zone: "Asia/Gaza", Abbr: []string{"EEST", "EET"}, CountryName: "Palestine", Comments: "Gaza Strip", Latitude: 31.5, Longitude: 34.4667},
+	{CountryCode: "PS", Zone: "Asia/Hebron", Abbr: []string{"EEST", "EET"}, CountryName: "Palestine", Comments: "West Bank", Latitude: 31.5333, Longitude: 35.095},
+	{CountryCode: "PT", Zone: "Atlantic/Azores", Abbr: []string(nil), CountryName: "Portugal", Comments: "Azores", Latitude: 37.7333, Longitude: -25.6667},
+	{CountryCode: "PT", Zone: "Atlantic/Madeira", Abbr: []string{"WEST", "WET"}, CountryName: "Portugal", Comments: "Madeira Islands", Latitude: 32.6333, Longitude: -16.9},
+	{CountryCode: "PT", Zone: "Europe/Lisbon", Abbr: []string{"WEST", "WET"}, CountryName: "Portugal", Comments: "Portugal (mainland)", Latitude: 38.7167, Longitude: -9.1333},
+	{CountryCode: "PW", Zone: "Pacific/Palau", Abbr: []string(nil), CountryName: "Palau", Comments: "", Latitude: 7.3333, Longitude: 134.4833},
+	{CountryCode: "PY", Zone: "America/Asuncion", Abbr: []string(nil), CountryName: "Paraguay", Comments: "", Latitude: -25.2667, Longitude: -57.6667},
+	{CountryCode: "QA", Zone: "Asia/Qatar", Abbr: []string(nil), CountryName: "Qatar", Comments: "", Latitude: 25.2833, Longitude: 51.5333},
+	{CountryCode: "RE", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "Réunion", Comments: "Crozet", Latitude: 25.3, Longitude: 55.3},
+	{CountryCode: "RO", Zone: "Europe/Bucharest", Abbr: []string{"EEST", "EET"}, CountryName: "Romania", Comments: "", Latitude: 44.4333, Longitude: 26.1},
+	{CountryCode: "RS", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Serbia", Comments: "", Latitude: 44.8333, Longitude: 20.5},
+	{CountryCode: "RU", Zone: "Asia/Anadyr", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+09 - Bering Sea", Latitude: 64.75, Longitude: 177.4833},
+	{CountryCode: "RU", Zone: "Asia/Barnaul", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Altai", Latitude: 53.3667, Longitude: 83.75},
+	{CountryCode: "RU", Zone: "Asia/Chita", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+06 - Zabaykalsky", Latitude: 52.05, Longitude: 113.4667},
+	{CountryCode: "RU", Zone: "Asia/Irkutsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+05 - Irkutsk, Buryatia", Latitude: 52.2667, Longitude: 104.3333},
+	{CountryCode: "RU", Zone: "Asia/Kamchatka", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+09 - Kamchatka", Latitude: 53.0167, Longitude: 158.65},
+	{CountryCode: "RU", Zone: "Asia/Khandyga", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+06 - Tomponsky, Ust-Maysky", Latitude: 62.6564, Longitude: 135.5539},
+	{CountryCode: "RU", Zone: "Asia/Krasnoyarsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Krasnoyarsk area", Latitude: 56.0167, Longitude: 92.8333},
+	{CountryCode: "RU", Zone: "Asia/Magadan", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+08 - Magadan", Latitude: 59.5667, Longitude: 150.8},
+	{CountryCode: "RU", Zone: "Asia/Novokuznetsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Kemerovo", Latitude: 53.75, Longitude: 87.1167},
+	{CountryCode: "RU", Zone: "Asia/Novosibirsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Novosibirsk", Latitude: 55.0333, Longitude: 82.9167},
+	{CountryCode: "RU", Zone: "Asia/Omsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+03 - Omsk", Latitude: 55, Longitude: 73.4},
+	{CountryCode: "RU", Zone: "Asia/Sakhalin", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+08 - Sakhalin Island", Latitude: 46.9667, Longitude: 142.7},
+	{CountryCode: "RU", Zone: "Asia/Srednekolymsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+08 - Sakha (E), N Kuril Is", Latitude: 67.4667, Longitude: 153.7167},
+	{CountryCode: "RU", Zone: "Asia/Tomsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+04 - Tomsk", Latitude: 56.5, Longitude: 84.9667},
+	{CountryCode: "RU", Zone: "Asia/Ust-Nera", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+07 - Oymyakonsky", Latitude: 64.5603, Longitude: 143.2267},
+	{CountryCode: "RU", Zone: "Asia/Vladivostok", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+07 - Amur River", Latitude: 43.1667, Longitude: 131.9333},
+	{CountryCode: "RU", Zone: "Asia/Yakutsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+06 - Lena River", Latitude: 62, Longitude: 129.6667},
+	{CountryCode: "RU", Zone: "Asia/Yekaterinburg", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+02 - Urals", Latitude: 56.85, Longitude: 60.6},
+	{CountryCode: "RU", Zone: "Europe/Astrakhan", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Astrakhan", Latitude: 46.35, Longitude: 48.05},
+	{CountryCode: "RU", Zone: "Europe/Kaliningrad", Abbr: []string{"EET"}, CountryName: "Russia", Comments: "MSK-01 - Kaliningrad", Latitude: 54.7167, Longitude: 20.5},
+	{CountryCode: "RU", Zone: "Europe/Kirov", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "MSK+00 - Kirov", Latitude: 58.6, Longitude: 49.65},
+	{CountryCode: "RU", Zone: "Europe/Moscow", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "MSK+00 - Moscow area", Latitude: 55.7558, Longitude: 37.6178},
+	{CountryCode: "RU", Zone: "Europe/Samara", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Samara, Udmurtia", Latitude: 53.2, Longitude: 50.15},
+	{CountryCode: "RU", Zone: "Europe/Saratov", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Saratov", Latitude: 51.5667, Longitude: 46.0333},
+	{CountryCode: "RU", Zone: "Europe/Simferopol", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "Crimea", Latitude: 44.95, Longitude: 34.1},
+	{CountryCode: "RU", Zone: "Europe/Ulyanovsk", Abbr: []string(nil), CountryName: "Russia", Comments: "MSK+01 - Ulyanovsk", Latitude: 54.3333, Longitude: 48.4},
+	{CountryCode: "RU", Zone: "Europe/Volgograd", Abbr: []string{"MSK"}, CountryName: "Russia", Comments: "MSK+00 - Volgograd", Latitude: 48.7333, Longitude: 44.4167},
+	{CountryCode: "RW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Rwanda", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "SA", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Saudi Arabia", Comments: "Syowa", Latitude: 24.6333, Longitude: 46.7167},
+	{CountryCode: "SB", Zone: "Pacific/Guadalcanal", Abbr: []string(nil), CountryName: "Solomon Islands", Comments: "Pohnpei", Latitude: -9.5333, Longitude: 160.2},
+	{CountryCode: "SC", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "Seychelles", Comments: "Crozet", Latitude: 25.3, Longitude: 55.3},
+	{CountryCode: "SD", Zone: "Africa/Khartoum", Abbr: []string{"CAT"}, CountryName: "Sudan", Comments: "", Latitude: 15.6, Longitude: 32.5333},
+	{CountryCode: "SE", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Sweden", Comments: "most of Germany", Latitude: 52.5, Longitude: 13.3667},
+	{CountryCode: "SG", Zone: "Asia/Singapore", Abbr: []string(nil), CountryName: "Singapore", Comments: "peninsular Malaysia, Concordia", Latitude: 1.2833, Longitude: 103.85},
+	{CountryCode: "SH", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "St Helena", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "SI", Zone: "Europe/Belgrade", Abbr: []string{"CEST", "CET"}, CountryName: "Slovenia", Comments: "", Latitude: 44.8333, Longitude: 20.5},
+	{CountryCode: "SJ", Zone: "Europe/Berlin", Abbr: []string{"CEST", "CET"}, CountryName: "Svalbard & Jan Mayen", Comments: "most of Germany", Latitude: 52.5, Longitude: 13.3667},
+	{CountryCode: "SK", Zone: "Europe/Prague", Abbr: []string{"CEST", "CET"}, CountryName: "Slovakia", Comments: "", Latitude: 50.0833, Longitude: 14.4333},
+	{CountryCode: "SL", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Sierra Leone", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "SM", Zone: "Europe/Rome", Abbr: []string{"CEST", "CET"}, CountryName: "San Marino", Comments: "", Latitude: 41.9, Longitude: 12.4833},
+	{CountryCode: "SN", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Senegal", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "SO", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Somalia", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "SR", Zone: "America/Paramaribo", Abbr: []string(nil), CountryName: "Suriname", Comments: "", Latitude: 5.8333, Longitude: -55.1667},
+	{CountryCode: "SS", Zone: "Africa/Juba", Abbr: []string{"CAT"}, CountryName: "South Sudan", Comments: "", Latitude: 4.85, Longitude: 31.6167},
+	{CountryCode: "ST", Zone: "Africa/Sao_Tome", Abbr: []string{"GMT"}, CountryName: "Sao Tome & Principe", Comments: "", Latitude: 0.3333, Longitude: 6.7333},
+	{CountryCode: "SV", Zone: "America/El_Salvador", Abbr: []string{"CST"}, CountryName: "El Salvador", Comments: "", Latitude: 13.7, Longitude: -89.2},
+	{CountryCode: "SX", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Maarten (Dutch)", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "SY", Zone: "Asia/Damascus", Abbr: []string(nil), CountryName: "Syria", Comments: "", Latitude: 33.5, Longitude: 36.3},
+	{CountryCode: "SZ", Zone: "Africa/Johannesburg", Abbr: []string{"SAST"}, CountryName: "Eswatini (Swaziland)", Comments: "", Latitude: -26.25, Longitude: 28},
+	{CountryCode: "TC", Zone: "America/Grand_Turk", Abbr: []string{"EDT", "EST"}, CountryName: "Turks & Caicos Is", Comments: "", Latitude: 21.4667, Longitude: -71.1333},
+	{CountryCode: "TD", Zone: "Africa/Ndjamena", Abbr: []string{"WAT"}, CountryName: "Chad", Comments: "", Latitude: 12.1167, Longitude: 15.05},
+	{CountryCode: "TF", Zone: "Asia/Dubai", Abbr: []string(nil), CountryName: "French S. Terr.", Comments: "Crozet", Latitude: 25.3, Longitude: 55.3},
+	{CountryCode: "TF", Zone: "Indian/Maldives", Abbr: []string(nil), CountryName: "French S. Terr.", Comments: "Kerguelen, St Paul I, Amsterdam I", Latitude: 4.1667, Longitude: 73.5},
+	{CountryCode: "TG", Zone: "Africa/Abidjan", Abbr: []string{"GMT"}, CountryName: "Togo", Comments: "", Latitude: 5.3167, Longitude: -4.0333},
+	{CountryCode: "TH", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Thailand", Comments: "north Vietnam", Latitude: 13.75, Longitude: 100.5167},
+	{CountryCode: "TJ", Zone: "Asia/Dushanbe", Abbr: []string(nil), CountryName: "Tajikistan", Comments: "", Latitude: 38.5833, Longitude: 68.8},
+	{CountryCode: "TK", Zone: "Pacific/Fakaofo", Abbr: []string(nil), CountryName: "Tokelau", Comments: "", Latitude: -9.3667, Longitude: -171.2333},
+	{CountryCode: "TL", Zone: "Asia/Dili", Abbr: []string(nil), CountryName: "East Timor", Comments: "", Latitude: -8.55, Longitude: 125.5833},
+	{CountryCode: "TM", Zone: "Asia/Ashgabat", Abbr: []string(nil), CountryName: "Turkmenistan", Comments: "", Latitude: 37.95, Longitude: 58.3833},
+	{CountryCode: "TN", Zone: "Africa/Tunis", Abbr: []string{"CET"}, CountryName: "Tunisia", Comments: "", Latitude: 36.8, Longitude: 10.1833},
+	{CountryCode: "TO", Zone: "Pacific/Tongatapu", Abbr: []string(nil), CountryName: "Tonga", Comments: "", Latitude: -21.1333, Longitude: -175.2},
+	{CountryCode: "TR", Zone: "Europe/Istanbul", Abbr: []string(nil), CountryName: "Turkey", Comments: "", Latitude: 41.0167, Longitude: 28.9667},
+	{CountryCode: "TT", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Trinidad & Tobago", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "TV", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Tuvalu", Comments: "Gilberts, Marshalls, Wake", Latitude: 1.4167, Longitude: 173},
+	{CountryCode: "TW", Zone: "Asia/Taipei", Abbr: []string{"CST"}, CountryName: "Taiwan", Comments: "", Latitude: 25.05, Longitude: 121.5},
+	{CountryCode: "TZ", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Tanzania", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "UA", Zone: "Europe/Kyiv", Abbr: []string{"EEST", "EET"}, CountryName: "Ukraine", Comments: "most of Ukraine", Latitude: 50.4333, Longitude: 30.5167},
+	{CountryCode: "UA", Zone: "Europe/Simferopol", Abbr: []string{"MSK"}, CountryName: "Ukraine", Comments: "Crimea", Latitude: 44.95, Longitude: 34.1},
+	{CountryCode: "UG", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Uganda", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "UM", Zone: "Pacific/Pago_Pago", Abbr: []string{"SST"}, CountryName: "US minor outlying islands", Comments: "Midway", Latitude: -14.2667, Longitude: -170.7},
+	{CountryCode: "UM", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "US minor outlying islands", Comments: "Gilberts, Marshalls, Wake", Latitude: 1.4167, Longitude: 173},
+	{CountryCode: "US", Zone: "America/Adak", Abbr: []string{"HDT", "HST"}, CountryName: "United States", Comments: "Alaska - western Aleutians", Latitude: 51.88, Longitude: -176.6581},
+	{CountryCode: "US", Zone: "America/Anchorage", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska (most areas)", Latitude: 61.2181, Longitude: -149.9003},
+	{CountryCode: "US", Zone: "America/Boise", Abbr: []string{"MDT", "MST"}, CountryName: "United States", Comments: "Mountain - ID (south), OR (east)", Latitude: 43.6136, Longitude: -116.2025},
+	{CountryCode: "US", Zone: "America/Chicago", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central (most areas)", Latitude: 41.85, Longitude: -87.65},
+	{CountryCode: "US", Zone: "America/Denver", Abbr: []string{"MDT", "MST"}, CountryName: "United States", Comments: "Mountain (most areas)", Latitude: 39.7392, Longitude: -104.9842},
+	{CountryCode: "US", Zone: "America/Detroit", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - MI (most areas)", Latitude: 42.3314, Longitude: -83.0458},
+	{CountryCode: "US", Zone: "America/Indiana/Indianapolis", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (most areas)", Latitude: 39.7683, Longitude: -86.1581},
+	{CountryCode: "US", Zone: "America/Indiana/Knox", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - IN (Starke)", Latitude: 41.2958, Longitude: -86.625},
+	{CountryCode: "US", Zone: "America/Indiana/Marengo", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Crawford)", Latitude: 38.3756, Longitude: -86.3447},
+	{CountryCode: "US", Zone: "America/Indiana/Petersburg", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Pike)", Latitude: 38.4919, Longitude: -87.2786},
+	{CountryCode: "US", Zone: "America/Indiana/Tell_City", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - IN (Perry)", Latitude: 37.9531, Longitude: -86.7614},
+	{CountryCode: "US", Zone: "America/Indiana/Vevay", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Switzerland)", Latitude: 38.7478, Longitude: -85.0672},
+	{CountryCode: "US", Zone: "America/Indiana/Vincennes", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Da, Du, K, Mn)", Latitude: 38.6772, Longitude: -87.5286},
+	{CountryCode: "US", Zone: "America/Indiana/Winamac", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - IN (Pulaski)", Latitude: 41.0514, Longitude: -86.6031},
+	{CountryCode: "US", Zone: "America/Juneau", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Juneau area", Latitude: 58.3019, Longitude: -134.4197},
+	{CountryCode: "US", Zone: "America/Kentucky/Louisville", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - KY (Louisville area)", Latitude: 38.2542, Longitude: -85.7594},
+	{CountryCode: "US", Zone: "America/Kentucky/Monticello", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern - KY (Wayne)", Latitude: 36.8297, Longitude: -84.8492},
+	{CountryCode: "US", Zone: "America/Los_Angeles", Abbr: []string{"PDT", "PST"}, CountryName: "United States", Comments: "Pacific", Latitude: 34.0522, Longitude: -118.2428},
+	{CountryCode: "US", Zone: "America/Menominee", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - MI (Wisconsin border)", Latitude: 45.1078, Longitude: -87.6142},
+	{CountryCode: "US", Zone: "America/Metlakatla", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Annette Island", Latitude: 55.1269, Longitude: -131.5764},
+	{CountryCode: "US", Zone: "America/New_York", Abbr: []string{"EDT", "EST"}, CountryName: "United States", Comments: "Eastern (most areas)", Latitude: 40.7142, Longitude: -74.0064},
+	{CountryCode: "US", Zone: "America/Nome", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska (west)", Latitude: 64.5011, Longitude: -165.4064},
+	{CountryCode: "US", Zone: "America/North_Dakota/Beulah", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - ND (Mercer)", Latitude: 47.2642, Longitude: -101.7778},
+	{CountryCode: "US", Zone: "America/North_Dakota/Center", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - ND (Oliver)", Latitude: 47.1164, Longitude: -101.2992},
+	{CountryCode: "US", Zone: "America/North_Dakota/New_Salem", Abbr: []string{"CDT", "CST"}, CountryName: "United States", Comments: "Central - ND (Morton rural)", Latitude: 46.845, Longitude: -101.4108},
+	{CountryCode: "US", Zone: "America/Phoenix", Abbr: []string{"MST"}, CountryName: "United States", Comments: "MST - AZ (most areas), Creston BC", Latitude: 33.4483, Longitude: -112.0733},
+	{CountryCode: "US", Zone: "America/Sitka", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Sitka area", Latitude: 57.1764, Longitude: -135.3019},
+	{CountryCode: "US", Zone: "America/Yakutat", Abbr: []string{"AKDT", "AKST"}, CountryName: "United States", Comments: "Alaska - Yakutat", Latitude: 59.5469, Longitude: -139.7272},
+	{CountryCode: "US", Zone: "Pacific/Honolulu", Abbr: []string{"HST"}, CountryName: "United States", Comments: "Hawaii", Latitude: 21.3069, Longitude: -157.8583},
+	{CountryCode: "UY", Zone: "America/Montevideo", Abbr: []string(nil), CountryName: "Uruguay", Comments: "", Latitude: -34.9092, Longitude: -56.2125},
+	{CountryCode: "UZ", Zone: "Asia/Samarkand", Abbr: []string(nil), CountryName: "Uzbekistan", Comments: "Uzbekistan (west)", Latitude: 39.6667, Longitude: 66.8},
+	{CountryCode: "UZ", Zone: "Asia/Tashkent", Abbr: []string(nil), CountryName: "Uzbekistan", Comments: "Uzbekistan (east)", Latitude: 41.3333, Longitude: 69.3},
+	{CountryCode: "VA", Zone: "Europe/Rome", Abbr: []string{"CEST", "CET"}, CountryName: "Vatican City", Comments: "", Latitude: 41.9, Longitude: 12.4833},
+	{CountryCode: "VC", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "St Vincent", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "VE", Zone: "America/Caracas", Abbr: []string(nil), CountryName: "Venezuela", Comments: "", Latitude: 10.5, Longitude: -66.9333},
+	{CountryCode: "VG", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Virgin Islands (UK)", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "VI", Zone: "America/Puerto_Rico", Abbr: []string{"AST"}, CountryName: "Virgin Islands (US)", Comments: "AST - QC (Lower North Shore)", Latitude: 18.4683, Longitude: -66.1061},
+	{CountryCode: "VN", Zone: "Asia/Bangkok", Abbr: []string(nil), CountryName: "Vietnam", Comments: "north Vietnam", Latitude: 13.75, Longitude: 100.5167},
+	{CountryCode: "VN", Zone: "Asia/Ho_Chi_Minh", Abbr: []string(nil), CountryName: "Vietnam", Comments: "south Vietnam", Latitude: 10.75, Longitude: 106.6667},
+	{CountryCode: "VU", Zone: "Pacific/Efate", Abbr: []string(nil), CountryName: "Vanuatu", Comments: "", Latitude: -17.6667, Longitude: 168.4167},
+	{CountryCode: "WF", Zone: "Pacific/Tarawa", Abbr: []string(nil), CountryName: "Wallis & Futuna", Comments: "Gilberts, Marshalls, Wake", Latitude: 1.4167, Longitude: 173},
+	{CountryCode: "WS", Zone: "Pacific/Apia", Abbr: []string(nil), CountryName: "Samoa (western)", Comments: "", Latitude: -13.8333, Longitude: -171.7333},
+	{CountryCode: "YE", Zone: "Asia/Riyadh", Abbr: []string(nil), CountryName: "Yemen", Comments: "Syowa", Latitude: 24.6333, Longitude: 46.7167},
+	{CountryCode: "YT", Zone: "Africa/Nairobi", Abbr: []string{"EAT"}, CountryName: "Mayotte", Comments: "", Latitude: -1.2833, Longitude: 36.8167},
+	{CountryCode: "ZA", Zone: "Africa/Johannesburg", Abbr: []string{"SAST"}, CountryName: "South Africa", Comments: "", Latitude: -26.25, Longitude: 28},
+	{CountryCode: "ZM", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Zambia", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+	{CountryCode: "ZW", Zone: "Africa/Maputo", Abbr: []string{"CAT"}, CountryName: "Zimbabwe", Comments: "Central Africa Time", Latitude: -25.9667, Longitude: 32.5833},
+}
+
+// countries is a list of all countries in iso3166.tab; this includes
+// countries without any zones.
+var countries = []*Country{
+	{Code: "AD", Name: "Andorra"},
+	{Code: "AE", Name: "United Arab Emirates"},
+	{Code: "AF", Name: "Afghanistan"},
+	{Code: "AG", Name: "Antigua & Barbuda"},
+	{Code: "AI", Name: "Anguilla"},
+	{Code: "AL", Name: "Albania"},
+	{Code: "AM", Name: "Armenia"},
+	{Code: "AO", Name: "Angola"},
+	{Code: "AQ", Name: "Antarctica"},
+	{Code: "AR", Name: "Argentina"},
+	{Code: "AS", Name: "Samoa (American)"},
+	{Code: "AT", Name: "Austria"},
+	{Code: "AU", Name: "Australia"},
+	{Code: "AW", Name: "Aruba"},
+	{Code: "AX", Name: "Åland Islands"},
+	{Code: "AZ", Name: "Azerbaijan"},
+	{Code: "BA", Name: "Bosnia & Herzegovina"},
+	{Code: "BB", Name: "Barbados"},
+	{Code: "BD", Name: "Bangladesh"},
+	{Code: "BE", Name: "Belgium"},
+	{Code: "BF", Name: "Burkina Faso"},
+	{Code: "BG", Name: "Bulgaria"},
+	{Code: "BH", Name: "Bahrain"},
+	{Code: "BI", Name: "Burundi"},
+	{Code: "BJ", Name: "Benin"},
+	{Code: "BL", Name: "St Barthelemy"},
+	{Code: "BM", Name: "Bermuda"},
+	{Code: "BN", Name: "Brunei"},
+	{Code: "BO", Name: "Bolivia"},
+	{Code: "BQ", Name: "Caribbean NL"},
+	{Code: "BR", Name: "Brazil"},
+	{Code: "BS", Name: "Bahamas"},
+	{Code: "BT", Name: "Bhutan"},
+	{Code: "BV", Name: "Bouvet Island"},
+	{Code: "BW", Name: "Botswana"},
+	{Code: "BY", Name: "Belarus"},
+	{Code: "BZ", Name: "Belize"},
+	{Code: "CA", Name: "Canada"},
+	{Code: "CC", Name: "Cocos (Keeling) Islands"},
+	{Code: "CD", Name: "Congo (Dem. Rep.)"},
+	{Code: "CF", Name: "Central African Rep."},
+	{Code: "CG", Name: "Congo (Rep.)"},
+	{Code: "CH", Name: "Switzerland"},
+	{Code: "CI", Name: "Côte d'Ivoire"},
+	{Code: "CK", Name: "Cook Islands"},
+	{Code: "CL", Name: "Chile"},
+	{Code: "CM", Name: "Cameroon"},
+	{Code: "CN", Name: "China"},
+	{Code: "CO", Name: "Colombia"},
+	{Code: "CR", Name: "Costa Rica"},
+	{Code: "CU", Name: "Cuba"},
+	{Code: "CV", Name: "Cape Verde"},
+	{Code: "CW", Name: "Curaçao"},
+	{Code: "CX", Name: "Christmas Island"},
+	{Code: "CY", Name: "Cyprus"},
+	{Code: "CZ", Name: "Czech Republic"},
+	{Code: "DE", Name: "Germany"},
+	{Code: "DJ", Name: "Djibouti"},
+	{Code: "DK", Name: "Denmark"},
+	{Code: "DM", Name: "Dominica"},
+	{Code: "DO", Name: "Dominican Republic"},
+	{Code: "DZ", Name: "Algeria"},
+	{Code: "EC", Name: "Ecuador"},
+	{Code: "EE", Name: "Estonia"},
+	{Code: "EG", Name: "Egypt"},
+	{Code: "EH", Name: "Western Sahara"},
+	{Code: "ER", Name: "Eritrea"},
+	{Code: "ES", Name: "Spain"},
+	{Code: "ET", Name: "Ethiopia"},
+	{Code: "FI", Name: "Finland"},
+	{Code: "FJ", Name: "Fiji"},
+	{Code: "FK", Name: "Falkland Islands"},
+	{Code: "FM", Name: "Micronesia"},
+	{Code: "FO", Name: "Faroe Islands"},
+	{Code: "FR", Name: "France"},
+	{Code: "GA", Name: "Gabon"},
+	{Code: "GB", Name: "Britain (UK)"},
+	{Code: "GD", Name: "Grenada"},
+	{Code: "GE", Name: "Georgia"},
+	{Code: "GF", Name: "French Guiana"},
+	{Code: "GG", Name: "Guernsey"},
+	{Code: "GH", Name: "Ghana"},
+	{Code: "GI", Name: "Gibraltar"},
+	{Code: "GL", Name: "Greenland"},
+	{Code: "GM", Name: "Gambia"},
+	{Code: "GN", Name: "Guinea"},
+	{Code: "GP", Name: "Guadeloupe"},
+	{Code: "GQ", Name: "Equatorial Guinea"},
+	{Code: "GR", Name: "Greece"},
+	{Code: "GS", Name: "South Georgia & the South Sandwich Islands"},
+	{Code: "GT", Name: "Guatemala"},
+	{Code: "GU", Name: "Guam"},
+	{Code: "GW", Name: "Guinea-Bissau"},
+	{Code: "GY", Name: "Guyana"},
+	{Code: "HK", Name: "Hong Kong"},
+	{Code: "HM", Name: "Heard Island & McDonald Islands"},
+	{Code: "HN", Name: "Honduras"},
+	{Code: "HR", Name: "Croatia"},
+	{Code: "HT", Name: "Haiti"},
+	{Code: "HU", Name: "Hungary"},
+	{Code: "ID", Name: "Indonesia"},
+	{Code: "IE", Name: "Ireland"},
+	{Code: "IL", Name: "Israel"},
+	{Code: "IM", Name: "Isle of Man"},
+	{Code: "IN", Name: "India"},
+	{Code: "IO", Name: "British Indian Ocean Territory"},
+	{Code: "IQ", Name: "Iraq"},
+	{Code: "IR", Name: "Iran"},
+	{Code: "IS", Name: "Iceland"},
+	{Code: "IT", Name: "Italy"},
+	{Code: "JE", Name: "Jersey"},
+	{Code: "JM", Name: "Jamaica"},
+	{Code: "JO", Name: "Jordan"},
+	{Code: "JP", Name: "Japan"},
+	{Code: "KE", Name: "Kenya"},
+	{Code: "KG", Name: "Kyrgyzstan"},
+	{Code: "KH", Name: "Cambodia"},
+	{Code: "KI", Name: "Kiribati"},
+	{Code: "KM", Name: "Comoros"},
+	{Code: "KN", Name: "St Kitts & Nevis"},
+	{Code: "KP", Name: "Korea (North)"},
+	{Code: "KR", Name: "Korea (South)"},
+	{Code: "KW", Name: "Kuwait"},
+	{Code: "KY", Name: "Cayman Islands"},
+	{Code: "KZ", Name: "Kazakhstan"},
+	{Code: "LA", Name: "Laos"},
+	{Code: "LB", Name: "Lebanon"},
+	{Code: "LC", Name: "St Lucia"},
+	{Code: "LI", Name: "Liechtenstein"},
+	{Code: "LK", Name: "Sri Lanka"},
+	{Code: "LR", Name: "Liberia"},
+	{Code: "LS", Name: "Lesotho"},
+	{Code: "LT", Name: "Lithuania"},
+	{Code: "LU", Name: "Luxembourg"},
+	{Code: "LV", Name: "Latvia"},
+	{Code: "LY", Name: "Libya"},
+	{Code: "MA", Name: "Morocco"},
+	{Code: "MC", Name: "Monaco"},
+	{Code: "MD", Name: "Moldova"},
+	{Code: "ME", Name: "Montenegro"},
+	{Code: "MF", Name: "St Martin (French)"},
+	{Code: "MG", Name: "Madagascar"},
+	{Code: "MH", Name: "Marshall Islands"},
+	{Code: "MK", Name: "North Macedonia"},
+	{Code: "ML", Name: "Mali"},
+	{Code: "MM", Name: "Myanmar (Burma)"},
+	{Code: "MN", Name: "Mongolia"},
+	{Code: "MO", Name: "Macau"},
+	{Code: "MP", Name: "Northern Mariana Islands"},
+	{Code: "MQ", Name: "Martinique"},
+	{Code: "MR", Name: "Mauritania"},
+	{Code: "MS", Name: "Montserrat"},
+	{Code: "MT", Name: "Malta"},
+	{Code: "MU", Name: "Mauritius"},
+	{Code: "MV", Name: "Maldives"},
+	{Code: "MW", Name: "Malawi"},
+	{Code: "MX", Name: "Mexico"},
+	{Code: "MY", Name: "Malaysia"},
+	{Code: "MZ", Name: "Mozambique"},
+	{Code: "NA", Name: "Namibia"},
+	{Code: "NC", Name: "New Caledonia"},
+	{Code: "NE", Name: "Niger"},
+	{Code: "NF", Name: "Norfolk Island"},
+	{Code: "NG", Name: "Nigeria"},
+	{Code: "NI", Name: "Nicaragua"},
+	{Code: "NL", Name: "Netherlands"},
+	{Code: "NO", Name: "Norway"},
+	{Code: "NP", Name: "Nepal"},
+	{Code: "NR", Name: "Nauru"},
+	{Code: "NU", Name: "Niue"},
+	{Code: "NZ", Name: "New Zealand"},
+	{Code: "OM", Name: "Oman"},
+	{Code: "PA", Name: "Panama"},
+	{Code: "PE", Name: "Peru"},
+	{Code: "PF", Name: "French Polynesia"},
+	{Code: "PG", Name: "Papua New Guinea"},
+	{Code: "PH", Name: "Philippines"},
+	{Code: "PK", Name: "Pakistan"},
+	{Code: "PL", Name: "Poland"},
+	{Code: "PM", Name: "St Pierre & Miquelon"},
+	{Code: "PN", Name: "Pitcairn"},
+	{Code: "PR", Name: "Puerto Rico"},
+	{Code: "PS", Name: "Palestine"},
+	{Code: "PT", Name: "Portugal"},
+	{Code: "PW", Name: "Palau"},
+	{Code: "PY", Name: "Paraguay"},
+	{Code: "QA", Name: "Qatar"},
+	{Code: "RE", Name: "Réunion"},
+	{Code: "RO", Name: "Romania"},
+	{Code: "RS", Name: "Serbia"},
+	{Code: "RU", Name: "Russia"},
+	{Code: "RW", Name: "Rwanda"},
+	{Code: "SA", Name: "Saudi Arabia"},
+	{Code: "SB", Name: "Solomon Islands"},
+	{Code: "SC", Name: "Seychelles"},
+	{Code: "SD", Name: "Sudan"},
+	{Code: "SE", Name: "Sweden"},
+	{Code: "SG", Name: "Singapore"},
+	{Code: "SH", Name: "St Helena"},
+	{Code: "SI", Name: "Slovenia"},
+	{Code: "SJ", Name: "Svalbard & Jan Mayen"},
+	{Code: "SK", Name: "Slovakia"},
+	{Code: "SL", Name: "Sierra Leone"},
+	{Code: "SM", Name: "San Marino"},
+	{Code: "SN", Name: "Senegal"},
+	{Code: "SO", Name: "Somalia"},
+	{Code: "SR", Name: "Suriname"},
+	{Code: "SS", Name: "South Sudan"},
+	{Code: "ST", Name: "Sao Tome & Principe"},
+	{Code: "SV", Name: "El Salvador"},
+	{Code: "SX", Name: "St Maarten (Dutch)"},
+	{Code: "SY", Name: "Syria"},
+	{Code: "SZ", Name: "Eswatini (Swaziland)"},
+	{Code: "TC", Name: "Turks & Caicos Is"},
+	{Code: "TD", Name: "Chad"},
+	{Code: "TF", Name: "French S. Terr."},
+	{Code: "TG", Name: "Togo"},
+	{Code: "TH", Name: "Thailand"},
+	{Code: "TJ", Name: "Tajikistan"},
+	{Code: "TK", Name: "Tokelau"},
+	{Code: "TL", Name: "East Timor"},
+	{Code: "TM", Name: "Turkmenistan"},
+	{Code: "TN", Name: "Tunisia"},
+	{Code: "TO", Name: "Tonga"},
+	{Code: "TR", Name: "Turkey"},
+	{Code: "TT", Name: "Trinidad & Tobago"},
+	{Code: "TV", Name: "Tuvalu"},
+	{Code: "TW", Name: "Taiwan"},
+	{Code: "TZ", Name: "Tanzania"},
+	{Code: "UA", Name: "Ukraine"},
+	{Code: "UG", Name: "Uganda"},
+	{Code: "UM", Name: "US minor outlying islands"},
+	{Code: "US", Name: "United States"},
+	{Code: "UY", Name: "Uruguay"},
+	{Code: "UZ", Name: "Uzbekistan"},
+	{Code: "VA", Name: "Vatican City"},
+	{Code: "VC", Name: "St Vincent"},
+	{Code: "VE", Name: "Venezuela"},
+	{Code: "VG", Name: "Virgin Islands (UK)"},
+	{Code: "VI", Name: "Virgin Islands (US)"},
+	{Code: "VN", Name: "Vietnam"},
+	{Code: "VU", Name: "Vanuatu"},
+	{Code: "WF", Name: "Wallis & Futuna"},
+	{Code: "WS", Name: "Samoa (western)"},
+	{Code: "YE", Name: "Yemen"},
+	{Code: "YT", Name: "Mayotte"},
+	{Code: "ZA", Name: "South Africa"},
+	{Code: "ZM", Name: "Zambia"},
+	{Code: "ZW", Name: "Zimbabwe"},
 }
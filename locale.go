@@ -0,0 +1,118 @@
+package tz
+
+import (
+	"strings"
+	"sync"
+)
+
+// Locale has translated country and city names for a language.
+//
+// Locales are generated from CLDR by gen.go in the zgo.at/tz/locale/...
+// packages, which register themselves when imported:
+//
+//	import _ "zgo.at/tz/locale/nl"
+//
+// This way only the languages you need are added to the binary.
+//
+// The de, es, fr, it, ja, ko, nl, pl, pt, ru, tr, and zh locales are included;
+// add the language to cldr/extract.go and run it and go generate for others.
+type Locale struct {
+	Lang      string            // BCP 47 language tag: nl, pt-BR
+	Countries map[string]string // Country code → name: ID → Indonesië
+	Cities    map[string]string // Zone → city name, if different from the zone name: Europe/Brussels → Brussel
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = make(map[string]*Locale)
+)
+
+// RegisterLocale registers the locale, replacing any previously registered
+// locale for this language.
+func RegisterLocale(l *Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(strings.ReplaceAll(l.Lang, "_", "-"))] = l
+}
+
+// Localized gets the locale for the language tag, such as "nl" or "pt-BR". The
+// base language is used if there is no locale for the region ("pt" for
+// "pt-BR").
+//
+// This returns nil if there is no locale for this language; all methods on
+// Locale work on nil, and return the English names.
+func Localized(lang string) *Locale {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	lang = strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+	for {
+		if l, ok := locales[lang]; ok {
+			return l
+		}
+		i := strings.LastIndexByte(lang, '-')
+		if i == -1 {
+			return nil
+		}
+		lang = lang[:i]
+	}
+}
+
+// parent gets the locale for the base language, if any.
+func (l *Locale) parent() *Locale {
+	i := strings.LastIndexAny(l.Lang, "-_")
+	if i == -1 {
+		return nil
+	}
+	return Localized(l.Lang[:i])
+}
+
+// CountryName gets the translated name of the zone's country, or the English
+// name if there is no translation.
+func (l *Locale) CountryName(z *Zone) string {
+	for ; l != nil; l = l.parent() {
+		if n, ok := l.Countries[z.CountryCode]; ok {
+			return n
+		}
+	}
+	return z.CountryName
+}
+
+// City gets the translated name of the zone's city, or the city from the zone
+// name if there is no translation: "America/Argentina/Buenos_Aires" → "Buenos
+// Aires".
+func (l *Locale) City(z *Zone) string {
+	for ; l != nil; l = l.parent() {
+		if n, ok := l.Cities[z.Zone]; ok {
+			return n
+		}
+	}
+	return z.city()
+}
+
+// Display is like Zone.Display(), but with the translated country and city
+// name instead of the zone name. The comments aren't translated, and are not
+// included.
+func (l *Locale) Display(z *Zone) string {
+	if z == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(l.CountryName(z))
+	b.WriteString(": ")
+	b.WriteString(l.City(z))
+	if len(z.Abbr) > 0 {
+		b.WriteString(" (")
+		b.WriteString(strings.Join(z.Abbr, ", "))
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// DisplayIn is like Display(), but with the country and city names translated
+// in the language tag, such as "nl" or "pt-BR".
+//
+// The locale for the language needs to be registered; see Locale.
+func (t *Zone) DisplayIn(lang string) string {
+	return Localized(lang).Display(t)
+}
@@ -0,0 +1,395 @@
+// Package es registers the es locale for zgo.at/tz, generated from CLDR.
+package es
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "es",
+		Countries: map[string]string{
+			"AD": "Andorra",
+			"AE": "Emiratos Árabes Unidos",
+			"AF": "Afganistán",
+			"AG": "Antigua y Barbuda",
+			"AI": "Anguila",
+			"AL": "Albania",
+			"AM": "Armenia",
+			"AO": "Angola",
+			"AQ": "Antártida",
+			"AR": "Argentina",
+			"AS": "Samoa Americana",
+			"AT": "Austria",
+			"AU": "Australia",
+			"AW": "Aruba",
+			"AX": "Islas Aland",
+			"AZ": "Azerbaiyán",
+			"BA": "Bosnia y Herzegovina",
+			"BB": "Barbados",
+			"BD": "Bangladés",
+			"BE": "Bélgica",
+			"BF": "Burkina Faso",
+			"BG": "Bulgaria",
+			"BH": "Baréin",
+			"BI": "Burundi",
+			"BJ": "Benín",
+			"BL": "San Bartolomé",
+			"BM": "Bermudas",
+			"BN": "Brunéi",
+			"BO": "Bolivia",
+			"BQ": "Caribe neerlandés",
+			"BR": "Brasil",
+			"BS": "Bahamas",
+			"BT": "Bután",
+			"BV": "Isla Bouvet",
+			"BW": "Botsuana",
+			"BY": "Bielorrusia",
+			"BZ": "Belice",
+			"CA": "Canadá",
+			"CC": "Islas Cocos",
+			"CD": "República Democrática del Congo",
+			"CF": "República Centroafricana",
+			"CG": "Congo",
+			"CH": "Suiza",
+			"CI": "Côte d’Ivoire",
+			"CK": "Islas Cook",
+			"CL": "Chile",
+			"CM": "Camerún",
+			"CN": "China",
+			"CO": "Colombia",
+			"CR": "Costa Rica",
+			"CU": "Cuba",
+			"CV": "Cabo Verde",
+			"CW": "Curazao",
+			"CX": "Isla de Navidad",
+			"CY": "Chipre",
+			"CZ": "Chequia",
+			"DE": "Alemania",
+			"DJ": "Yibuti",
+			"DK": "Dinamarca",
+			"DM": "Dominica",
+			"DO": "República Dominicana",
+			"DZ": "Argelia",
+			"EC": "Ecuador",
+			"EE": "Estonia",
+			"EG": "Egipto",
+			"EH": "Sáhara Occidental",
+			"ER": "Eritrea",
+			"ES": "España",
+			"ET": "Etiopía",
+			"FI": "Finlandia",
+			"FJ": "Fiyi",
+			"FK": "Islas Malvinas",
+			"FM": "Micronesia",
+			"FO": "Islas Feroe",
+			"FR": "Francia",
+			"GA": "Gabón",
+			"GB": "Reino Unido",
+			"GD": "Granada",
+			"GE": "Georgia",
+			"GF": "Guayana Francesa",
+			"GG": "Guernesey",
+			"GH": "Ghana",
+			"GI": "Gibraltar",
+			"GL": "Groenlandia",
+			"GM": "Gambia",
+			"GN": "Guinea",
+			"GP": "Guadalupe",
+			"GQ": "Guinea Ecuatorial",
+			"GR": "Grecia",
+			"GS": "Islas Georgia del Sur y Sandwich del Sur",
+			"GT": "Guatemala",
+			"GU": "Guam",
+			"GW": "Guinea-Bisáu",
+			"GY": "Guyana",
+			"HK": "RAE de Hong Kong (China)",
+			"HM": "Islas Heard y McDonald",
+			"HN": "Honduras",
+			"HR": "Croacia",
+			"HT": "Haití",
+			"HU": "Hungría",
+			"ID": "Indonesia",
+			"IE": "Irlanda",
+			"IL": "Israel",
+			"IM": "Isla de Man",
+			"IN": "India",
+			"IO": "Territorio Británico del Océano Índico",
+			"IQ": "Irak",
+			"IR": "Irán",
+			"IS": "Islandia",
+			"IT": "Italia",
+			"JE": "Jersey",
+			"JM": "Jamaica",
+			"JO": "Jordania",
+			"JP": "Japón",
+			"KE": "Kenia",
+			"KG": "Kirguistán",
+			"KH": "Camboya",
+			"KI": "Kiribati",
+			"KM": "Comoras",
+			"KN": "San Cristóbal y Nieves",
+			"KP": "Corea del Norte",
+			"KR": "Corea del Sur",
+			"KW": "Kuwait",
+			"KY": "Islas Caimán",
+			"KZ": "Kazajistán",
+			"LA": "Laos",
+			"LB": "Líbano",
+			"LC": "Santa Lucía",
+			"LI": "Liechtenstein",
+			"LK": "Sri Lanka",
+			"LR": "Liberia",
+			"LS": "Lesoto",
+			"LT": "Lituania",
+			"LU": "Luxemburgo",
+			"LV": "Letonia",
+			"LY": "Libia",
+			"MA": "Marruecos",
+			"MC": "Mónaco",
+			"MD": "Moldavia",
+			"ME": "Montenegro",
+			"MF": "San Martín",
+			"MG": "Madagascar",
+			"MH": "Islas Marshall",
+			"MK": "Macedonia del Norte",
+			"ML": "Mali",
+			"MM": "Myanmar (Birmania)",
+			"MN": "Mongolia",
+			"MO": "RAE de Macao (China)",
+			"MP": "Islas Marianas del Norte",
+			"MQ": "Martinica",
+			"MR": "Mauritania",
+			"MS": "Montserrat",
+			"MT": "Malta",
+			"MU": "Mauricio",
+			"MV": "Maldivas",
+			"MW": "Malaui",
+			"MX": "México",
+			"MY": "Malasia",
+			"MZ": "Mozambique",
+			"NA": "Namibia",
+			"NC": "Nueva Caledonia",
+			"NE": "Níger",
+			"NF": "Isla Norfolk",
+			"NG": "Nigeria",
+			"NI": "Nicaragua",
+			"NL": "Países Bajos",
+			"NO": "Noruega",
+			"NP": "Nepal",
+			"NR": "Nauru",
+			"NU": "Niue",
+			"NZ": "Nueva Zelanda",
+			"OM": "Omán",
+			"PA": "Panamá",
+			"PE": "Perú",
+			"PF": "Polinesia Francesa",
+			"PG": "Papúa Nueva Guinea",
+			"PH": "Filipinas",
+			"PK": "Pakistán",
+			"PL": "Polonia",
+			"PM": "San Pedro y Miquelón",
+			"PN": "Islas Pitcairn",
+			"PR": "Puerto Rico",
+			"PS": "Territorios Palestinos",
+			"PT": "Portugal",
+			"PW": "Palaos",
+			"PY": "Paraguay",
+			"QA": "Catar",
+			"RE": "Reunión",
+			"RO": "Rumanía",
+			"RS": "Serbia",
+			"RU": "Rusia",
+			"RW": "Ruanda",
+			"SA": "Arabia Saudí",
+			"SB": "Islas Salomón",
+			"SC": "Seychelles",
+			"SD": "Sudán",
+			"SE": "Suecia",
+			"SG": "Singapur",
+			"SH": "Santa Elena",
+			"SI": "Eslovenia",
+			"SJ": "Svalbard y Jan Mayen",
+			"SK": "Eslovaquia",
+			"SL": "Sierra Leona",
+			"SM": "San Marino",
+			"SN": "Senegal",
+			"SO": "Somalia",
+			"SR": "Surinam",
+			"SS": "Sudán del Sur",
+			"ST": "Santo Tomé y Príncipe",
+			"SV": "El Salvador",
+			"SX": "Sint Maarten",
+			"SY": "Siria",
+			"SZ": "Esuatini",
+			"TC": "Islas Turcas y Caicos",
+			"TD": "Chad",
+			"TF": "Territorios Australes Franceses",
+			"TG": "Togo",
+			"TH": "Tailandia",
+			"TJ": "Tayikistán",
+			"TK": "Tokelau",
+			"TL": "Timor-Leste",
+			"TM": "Turkmenistán",
+			"TN": "Túnez",
+			"TO": "Tonga",
+			"TR": "Turquía",
+			"TT": "Trinidad y Tobago",
+			"TV": "Tuvalu",
+			"TW": "Taiwán",
+			"TZ": "Tanzania",
+			"UA": "Ucrania",
+			"UG": "Uganda",
+			"UM": "Islas menores alejadas de EE. UU.",
+			"US": "Estados Unidos",
+			"UY": "Uruguay",
+			"UZ": "Uzbekistán",
+			"VA": "Ciudad del Vaticano",
+			"VC": "San Vicente y las Granadinas",
+			"VE": "Venezuela",
+			"VG": "Islas Vírgenes Británicas",
+			"VI": "Islas Vírgenes de EE. UU.",
+			"VN": "Vietnam",
+			"VU": "Vanuatu",
+			"WF": "Wallis y Futuna",
+			"WS": "Samoa",
+			"YE": "Yemen",
+			"YT": "Mayotte",
+			"ZA": "Sudáfrica",
+			"ZM": "Zambia",
+			"ZW": "Zimbabue",
+		},
+		Cities: map[string]string{
+			"Africa/Abidjan":                 "Abiyán",
+			"Africa/Algiers":                 "Argel",
+			"Africa/Bissau":                  "Bisáu",
+			"Africa/Cairo":                   "El Cairo",
+			"Africa/El_Aaiun":                "El Aaiún",
+			"Africa/Johannesburg":            "Johannesburgo",
+			"Africa/Khartoum":                "Jartum",
+			"Africa/Ndjamena":                "Yamena",
+			"Africa/Sao_Tome":                "Santo Tomé",
+			"Africa/Tripoli":                 "Trípoli",
+			"Africa/Tunis":                   "Túnez",
+			"America/Araguaina":              "Araguaína",
+			"America/Argentina/Cordoba":      "Córdoba",
+			"America/Argentina/Rio_Gallegos": "Río Gallegos",
+			"America/Argentina/Tucuman":      "Tucumán",
+			"America/Bahia":                  "Bahía",
+			"America/Belem":                  "Belén",
+			"America/Belize":                 "Belice",
+			"America/Bogota":                 "Bogotá",
+			"America/Cayenne":                "Cayena",
+			"America/Cuiaba":                 "Cuiabá",
+			"America/Eirunepe":               "Eirunepé",
+			"America/Grand_Turk":             "Gran Turca",
+			"America/Havana":                 "La Habana",
+			"America/Indiana/Indianapolis":   "Indianápolis",
+			"America/Los_Angeles":            "Los Ángeles",
+			"America/Maceio":                 "Maceió",
+			"America/Manaus":                 "Manaos",
+			"America/Martinique":             "Martinica",
+			"America/Mazatlan":               "Mazatlán",
+			"America/Mexico_City":            "Ciudad de México",
+			"America/Miquelon":               "Miquelón",
+			"America/New_York":               "Nueva York",
+			"America/North_Dakota/Beulah":    "Beulah, Dakota del Norte",
+			"America/North_Dakota/Center":    "Center, Dakota del Norte",
+			"America/North_Dakota/New_Salem": "New Salem, Dakota del Norte",
+			"America/Panama":                 "Panamá",
+			"America/Port-au-Prince":         "Puerto Príncipe",
+			"America/Rio_Branco":             "Río Branco",
+			"America/Santarem":               "Santarém",
+			"America/Santiago":               "Santiago de Chile",
+			"America/Sao_Paulo":              "São Paulo",
+			"America/St_Johns":               "San Juan de Terranova",
+			"Asia/Amman":                     "Ammán",
+			"Asia/Anadyr":                    "Anádyr",
+			"Asia/Aqtau":                     "Aktau",
+			"Asia/Aqtobe":                    "Aktobe",
+			"Asia/Ashgabat":                  "Asjabad",
+			"Asia/Baghdad":                   "Bagdad",
+			"Asia/Baku":                      "Bakú",
+			"Asia/Barnaul":                   "Barnaúl",
+			"Asia/Chita":                     "Chitá",
+			"Asia/Damascus":                  "Damasco",
+			"Asia/Dhaka":                     "Daca",
+			"Asia/Dubai":                     "Dubái",
+			"Asia/Dushanbe":                  "Dusambé",
+			"Asia/Hebron":                    "Hebrón",
+			"Asia/Ho_Chi_Minh":               "Ciudad Ho Chi Minh",
+			"Asia/Jakarta":                   "Yakarta",
+			"Asia/Jerusalem":                 "Jerusalén",
+			"Asia/Kathmandu":                 "Katmandú",
+			"Asia/Khandyga":                  "Khandiga",
+			"Asia/Kolkata":                   "Calcuta",
+			"Asia/Magadan":                   "Magadán",
+			"Asia/Makassar":                  "Makasar",
+			"Asia/Qatar":                     "Catar",
+			"Asia/Qostanay":                  "Kostanái",
+			"Asia/Qyzylorda":                 "Kyzylorda",
+			"Asia/Riyadh":                    "Riad",
+			"Asia/Sakhalin":                  "Sajalín",
+			"Asia/Samarkand":                 "Samarcanda",
+			"Asia/Seoul":                     "Seúl",
+			"Asia/Shanghai":                  "Shanghái",
+			"Asia/Singapore":                 "Singapur",
+			"Asia/Srednekolymsk":             "Srednekolimsk",
+			"Asia/Taipei":                    "Taipéi",
+			"Asia/Tashkent":                  "Taskent",
+			"Asia/Tbilisi":                   "Tiflis",
+			"Asia/Tehran":                    "Teherán",
+			"Asia/Thimphu":                   "Timbu",
+			"Asia/Tokyo":                     "Tokio",
+			"Asia/Ulaanbaatar":               "Ulán Bator",
+			"Asia/Urumqi":                    "Ürümqi",
+			"Asia/Yangon":                    "Yangón (Rangún)",
+			"Asia/Yekaterinburg":             "Ekaterimburgo",
+			"Asia/Yerevan":                   "Ereván",
+			"Atlantic/Bermuda":               "Bermudas",
+			"Atlantic/Canary":                "Canarias",
+			"Atlantic/Cape_Verde":            "Cabo Verde",
+			"Atlantic/Faroe":                 "Islas Feroe",
+			"Atlantic/South_Georgia":         "Georgia del Sur",
+			"Australia/Adelaide":             "Adelaida",
+			"Australia/Sydney":               "Sídney",
+			"Europe/Astrakhan":               "Astracán",
+			"Europe/Athens":                  "Atenas",
+			"Europe/Belgrade":                "Belgrado",
+			"Europe/Berlin":                  "Berlín",
+			"Europe/Brussels":                "Bruselas",
+			"Europe/Bucharest":               "Bucarest",
+			"Europe/Chisinau":                "Chisináu",
+			"Europe/Dublin":                  "Dublín",
+			"Europe/Istanbul":                "Estambul",
+			"Europe/Kaliningrad":             "Kaliningrado",
+			"Europe/Kirov":                   "Kírov",
+			"Europe/Kyiv":                    "Kiev",
+			"Europe/Lisbon":                  "Lisboa",
+			"Europe/London":                  "Londres",
+			"Europe/Moscow":                  "Moscú",
+			"Europe/Paris":                   "París",
+			"Europe/Prague":                  "Praga",
+			"Europe/Rome":                    "Roma",
+			"Europe/Saratov":                 "Sarátov",
+			"Europe/Simferopol":              "Simferópol",
+			"Europe/Sofia":                   "Sofía",
+			"Europe/Tallinn":                 "Tallin",
+			"Europe/Tirane":                  "Tirana",
+			"Europe/Ulyanovsk":               "Uliánovsk",
+			"Europe/Vienna":                  "Viena",
+			"Europe/Vilnius":                 "Vilna",
+			"Europe/Volgograd":               "Volgogrado",
+			"Europe/Warsaw":                  "Varsovia",
+			"Europe/Zurich":                  "Zúrich",
+			"Indian/Maldives":                "Maldivas",
+			"Indian/Mauritius":               "Mauricio",
+			"Pacific/Easter":                 "Isla de Pascua",
+			"Pacific/Fiji":                   "Fiyi",
+			"Pacific/Galapagos":              "Galápagos",
+			"Pacific/Honolulu":               "Honolulú",
+			"Pacific/Kanton":                 "Enderbury",
+			"Pacific/Noumea":                 "Numea",
+			"Pacific/Palau":                  "Palaos",
+			"Pacific/Tahiti":                 "Tahití",
+		},
+	})
+}
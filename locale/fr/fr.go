@@ -0,0 +1,377 @@
+// Package fr registers the fr locale for zgo.at/tz, generated from CLDR.
+package fr
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "fr",
+		Countries: map[string]string{
+			"AD": "Andorre",
+			"AE": "Émirats arabes unis",
+			"AF": "Afghanistan",
+			"AG": "Antigua-et-Barbuda",
+			"AI": "Anguilla",
+			"AL": "Albanie",
+			"AM": "Arménie",
+			"AO": "Angola",
+			"AQ": "Antarctique",
+			"AR": "Argentine",
+			"AS": "Samoa américaines",
+			"AT": "Autriche",
+			"AU": "Australie",
+			"AW": "Aruba",
+			"AX": "Îles Åland",
+			"AZ": "Azerbaïdjan",
+			"BA": "Bosnie-Herzégovine",
+			"BB": "Barbade",
+			"BD": "Bangladesh",
+			"BE": "Belgique",
+			"BF": "Burkina Faso",
+			"BG": "Bulgarie",
+			"BH": "Bahreïn",
+			"BI": "Burundi",
+			"BJ": "Bénin",
+			"BL": "Saint-Barthélemy",
+			"BM": "Bermudes",
+			"BN": "Brunei",
+			"BO": "Bolivie",
+			"BQ": "Pays-Bas caribéens",
+			"BR": "Brésil",
+			"BS": "Bahamas",
+			"BT": "Bhoutan",
+			"BV": "Île Bouvet",
+			"BW": "Botswana",
+			"BY": "Biélorussie",
+			"BZ": "Belize",
+			"CA": "Canada",
+			"CC": "Îles Cocos",
+			"CD": "Congo-Kinshasa",
+			"CF": "République centrafricaine",
+			"CG": "Congo-Brazzaville",
+			"CH": "Suisse",
+			"CI": "Côte d’Ivoire",
+			"CK": "Îles Cook",
+			"CL": "Chili",
+			"CM": "Cameroun",
+			"CN": "Chine",
+			"CO": "Colombie",
+			"CR": "Costa Rica",
+			"CU": "Cuba",
+			"CV": "Cap-Vert",
+			"CW": "Curaçao",
+			"CX": "Île Christmas",
+			"CY": "Chypre",
+			"CZ": "Tchéquie",
+			"DE": "Allemagne",
+			"DJ": "Djibouti",
+			"DK": "Danemark",
+			"DM": "Dominique",
+			"DO": "République dominicaine",
+			"DZ": "Algérie",
+			"EC": "Équateur",
+			"EE": "Estonie",
+			"EG": "Égypte",
+			"EH": "Sahara occidental",
+			"ER": "Érythrée",
+			"ES": "Espagne",
+			"ET": "Éthiopie",
+			"FI": "Finlande",
+			"FJ": "Fidji",
+			"FK": "Îles Malouines",
+			"FM": "Micronésie",
+			"FO": "Îles Féroé",
+			"FR": "France",
+			"GA": "Gabon",
+			"GB": "Royaume-Uni",
+			"GD": "Grenade",
+			"GE": "Géorgie",
+			"GF": "Guyane française",
+			"GG": "Guernesey",
+			"GH": "Ghana",
+			"GI": "Gibraltar",
+			"GL": "Groenland",
+			"GM": "Gambie",
+			"GN": "Guinée",
+			"GP": "Guadeloupe",
+			"GQ": "Guinée équatoriale",
+			"GR": "Grèce",
+			"GS": "Géorgie du Sud-et-les Îles Sandwich du Sud",
+			"GT": "Guatemala",
+			"GU": "Guam",
+			"GW": "Guinée-Bissau",
+			"GY": "Guyana",
+			"HK": "R.A.S. chinoise de Hong Kong",
+			"HM": "Îles Heard-et-MacDonald",
+			"HN": "Honduras",
+			"HR": "Croatie",
+			"HT": "Haïti",
+			"HU": "Hongrie",
+			"ID": "Indonésie",
+			"IE": "Irlande",
+			"IL": "Israël",
+			"IM": "Île de Man",
+			"IN": "Inde",
+			"IO": "Territoire britannique de l’océan Indien",
+			"IQ": "Irak",
+			"IR": "Iran",
+			"IS": "Islande",
+			"IT": "Italie",
+			"JE": "Jersey",
+			"JM": "Jamaïque",
+			"JO": "Jordanie",
+			"JP": "Japon",
+			"KE": "Kenya",
+			"KG": "Kirghizstan",
+			"KH": "Cambodge",
+			"KI": "Kiribati",
+			"KM": "Comores",
+			"KN": "Saint-Christophe-et-Niévès",
+			"KP": "Corée du Nord",
+			"KR": "Corée du Sud",
+			"KW": "Koweït",
+			"KY": "Îles Caïmans",
+			"KZ": "Kazakhstan",
+			"LA": "Laos",
+			"LB": "Liban",
+			"LC": "Sainte-Lucie",
+			"LI": "Liechtenstein",
+			"LK": "Sri Lanka",
+			"LR": "Liberia",
+			"LS": "Lesotho",
+			"LT": "Lituanie",
+			"LU": "Luxembourg",
+			"LV": "Lettonie",
+			"LY": "Libye",
+			"MA": "Maroc",
+			"MC": "Monaco",
+			"MD": "Moldavie",
+			"ME": "Monténégro",
+			"MF": "Saint-Martin",
+			"MG": "Madagascar",
+			"MH": "Îles Marshall",
+			"MK": "Macédoine du Nord",
+			"ML": "Mali",
+			"MM": "Myanmar (Birmanie)",
+			"MN": "Mongolie",
+			"MO": "R.A.S. chinoise de Macao",
+			"MP": "Îles Mariannes du Nord",
+			"MQ": "Martinique",
+			"MR": "Mauritanie",
+			"MS": "Montserrat",
+			"MT": "Malte",
+			"MU": "Maurice",
+			"MV": "Maldives",
+			"MW": "Malawi",
+			"MX": "Mexique",
+			"MY": "Malaisie",
+			"MZ": "Mozambique",
+			"NA": "Namibie",
+			"NC": "Nouvelle-Calédonie",
+			"NE": "Niger",
+			"NF": "Île Norfolk",
+			"NG": "Nigeria",
+			"NI": "Nicaragua",
+			"NL": "Pays-Bas",
+			"NO": "Norvège",
+			"NP": "Népal",
+			"NR": "Nauru",
+			"NU": "Niue",
+			"NZ": "Nouvelle-Zélande",
+			"OM": "Oman",
+			"PA": "Panama",
+			"PE": "Pérou",
+			"PF": "Polynésie française",
+			"PG": "Papouasie-Nouvelle-Guinée",
+			"PH": "Philippines",
+			"PK": "Pakistan",
+			"PL": "Pologne",
+			"PM": "Saint-Pierre-et-Miquelon",
+			"PN": "Îles Pitcairn",
+			"PR": "Porto Rico",
+			"PS": "Territoires palestiniens",
+			"PT": "Portugal",
+			"PW": "Palaos",
+			"PY": "Paraguay",
+			"QA": "Qatar",
+			"RE": "La Réunion",
+			"RO": "Roumanie",
+			"RS": "Serbie",
+			"RU": "Russie",
+			"RW": "Rwanda",
+			"SA": "Arabie saoudite",
+			"SB": "Îles Salomon",
+			"SC": "Seychelles",
+			"SD": "Soudan",
+			"SE": "Suède",
+			"SG": "Singapour",
+			"SH": "Sainte-Hélène",
+			"SI": "Slovénie",
+			"SJ": "Svalbard et Jan Mayen",
+			"SK": "Slovaquie",
+			"SL": "Sierra Leone",
+			"SM": "Saint-Marin",
+			"SN": "Sénégal",
+			"SO": "Somalie",
+			"SR": "Suriname",
+			"SS": "Soudan du Sud",
+			"ST": "Sao Tomé-et-Principe",
+			"SV": "Salvador",
+			"SX": "Saint-Martin (partie néerlandaise)",
+			"SY": "Syrie",
+			"SZ": "Eswatini",
+			"TC": "Îles Turques-et-Caïques",
+			"TD": "Tchad",
+			"TF": "Terres australes françaises",
+			"TG": "Togo",
+			"TH": "Thaïlande",
+			"TJ": "Tadjikistan",
+			"TK": "Tokelau",
+			"TL": "Timor oriental",
+			"TM": "Turkménistan",
+			"TN": "Tunisie",
+			"TO": "Tonga",
+			"TR": "Turquie",
+			"TT": "Trinité-et-Tobago",
+			"TV": "Tuvalu",
+			"TW": "Taïwan",
+			"TZ": "Tanzanie",
+			"UA": "Ukraine",
+			"UG": "Ouganda",
+			"UM": "Îles mineures éloignées des États-Unis",
+			"US": "États-Unis",
+			"UY": "Uruguay",
+			"UZ": "Ouzbékistan",
+			"VA": "État de la Cité du Vatican",
+			"VC": "Saint-Vincent-et-les Grenadines",
+			"VE": "Venezuela",
+			"VG": "Îles Vierges britanniques",
+			"VI": "Îles Vierges des États-Unis",
+			"VN": "Viêt Nam",
+			"VU": "Vanuatu",
+			"WF": "Wallis-et-Futuna",
+			"WS": "Samoa",
+			"YE": "Yémen",
+			"YT": "Mayotte",
+			"ZA": "Afrique du Sud",
+			"ZM": "Zambie",
+			"ZW": "Zimbabwe",
+		},
+		Cities: map[string]string{
+			"Africa/Algiers":                 "Alger",
+			"Africa/Cairo":                   "Le Caire",
+			"Africa/El_Aaiun":                "Laâyoune",
+			"Africa/Ndjamena":                "N’Djamena",
+			"Africa/Tripoli":                 "Tripoli (Libye)",
+			"America/Araguaina":              "Araguaína",
+			"America/Argentina/Cordoba":      "Córdoba",
+			"America/Argentina/Rio_Gallegos": "Río Gallegos",
+			"America/Argentina/Tucuman":      "Tucumán",
+			"America/Argentina/Ushuaia":      "Ushuaïa",
+			"America/Bahia_Banderas":         "Bahia de Banderas",
+			"America/Barbados":               "La Barbade",
+			"America/Belem":                  "Belém",
+			"America/Cuiaba":                 "Cuiabá",
+			"America/Detroit":                "Détroit",
+			"America/Eirunepe":               "Eirunepé",
+			"America/Havana":                 "La Havane",
+			"America/Indiana/Knox":           "Knox [Indiana]",
+			"America/Indiana/Marengo":        "Marengo [Indiana]",
+			"America/Indiana/Petersburg":     "Petersburg [Indiana]",
+			"America/Indiana/Tell_City":      "Tell City [Indiana]",
+			"America/Indiana/Vevay":          "Vevay [Indiana]",
+			"America/Indiana/Vincennes":      "Vincennes [Indiana]",
+			"America/Indiana/Winamac":        "Winamac [Indiana]",
+			"America/Jamaica":                "Jamaïque",
+			"America/Kentucky/Monticello":    "Monticello [Kentucky]",
+			"America/Maceio":                 "Maceió",
+			"America/Manaus":                 "Manaos",
+			"America/Mazatlan":               "Mazatlán",
+			"America/Mexico_City":            "Mexico",
+			"America/North_Dakota/Beulah":    "Beulah (Dakota du Nord)",
+			"America/North_Dakota/Center":    "Center (Dakota du Nord)",
+			"America/North_Dakota/New_Salem": "New Salem (Dakota du Nord)",
+			"America/Puerto_Rico":            "Porto Rico",
+			"America/Santarem":               "Santarém",
+			"America/Santo_Domingo":          "Saint-Domingue",
+			"America/Sao_Paulo":              "São Paulo",
+			"America/St_Johns":               "Saint-Jean de Terre-Neuve",
+			"America/Thule":                  "Thulé",
+			"Asia/Almaty":                    "Alma Ata",
+			"Asia/Aqtau":                     "Aktaou",
+			"Asia/Aqtobe":                    "Aktioubinsk",
+			"Asia/Ashgabat":                  "Achgabat",
+			"Asia/Atyrau":                    "Atyraou",
+			"Asia/Baghdad":                   "Bagdad",
+			"Asia/Baku":                      "Bakou",
+			"Asia/Beirut":                    "Beyrouth",
+			"Asia/Bishkek":                   "Bichkek",
+			"Asia/Chita":                     "Tchita",
+			"Asia/Damascus":                  "Damas",
+			"Asia/Dubai":                     "Dubaï",
+			"Asia/Dushanbe":                  "Douchanbé",
+			"Asia/Famagusta":                 "Famagouste",
+			"Asia/Hebron":                    "Hébron",
+			"Asia/Ho_Chi_Minh":               "Hô-Chi-Minh-Ville",
+			"Asia/Irkutsk":                   "Irkoutsk",
+			"Asia/Jerusalem":                 "Jérusalem",
+			"Asia/Kabul":                     "Kaboul",
+			"Asia/Kamchatka":                 "Kamtchatka",
+			"Asia/Kathmandu":                 "Katmandou",
+			"Asia/Kolkata":                   "Calcutta",
+			"Asia/Krasnoyarsk":               "Krasnoïarsk",
+			"Asia/Makassar":                  "Macassar",
+			"Asia/Manila":                    "Manille",
+			"Asia/Nicosia":                   "Nicosie",
+			"Asia/Novosibirsk":               "Novossibirsk",
+			"Asia/Oral":                      "Ouralsk",
+			"Asia/Qostanay":                  "Kostanaï",
+			"Asia/Qyzylorda":                 "Kzyl Orda",
+			"Asia/Riyadh":                    "Riyad",
+			"Asia/Sakhalin":                  "Sakhaline",
+			"Asia/Samarkand":                 "Samarcande",
+			"Asia/Seoul":                     "Séoul",
+			"Asia/Singapore":                 "Singapour",
+			"Asia/Tashkent":                  "Tachkent",
+			"Asia/Tbilisi":                   "Tbilissi",
+			"Asia/Tehran":                    "Téhéran",
+			"Asia/Ulaanbaatar":               "Oulan-Bator",
+			"Asia/Urumqi":                    "Ürümqi",
+			"Asia/Yakutsk":                   "Iakoutsk",
+			"Asia/Yangon":                    "Rangoun",
+			"Asia/Yekaterinburg":             "Ekaterinbourg",
+			"Asia/Yerevan":                   "Erevan",
+			"Atlantic/Azores":                "Açores",
+			"Atlantic/Bermuda":               "Bermudes",
+			"Atlantic/Canary":                "Îles Canaries",
+			"Atlantic/Cape_Verde":            "Cap-Vert",
+			"Atlantic/Faroe":                 "Îles Féroé",
+			"Atlantic/Madeira":               "Madère",
+			"Atlantic/South_Georgia":         "Géorgie du Sud",
+			"Australia/Adelaide":             "Adélaïde",
+			"Europe/Andorra":                 "Andorre",
+			"Europe/Athens":                  "Athènes",
+			"Europe/Brussels":                "Bruxelles",
+			"Europe/Bucharest":               "Bucarest",
+			"Europe/Kyiv":                    "Kiev",
+			"Europe/Lisbon":                  "Lisbonne",
+			"Europe/London":                  "Londres",
+			"Europe/Malta":                   "Malte",
+			"Europe/Moscow":                  "Moscou",
+			"Europe/Tirane":                  "Tirana",
+			"Europe/Ulyanovsk":               "Oulianovsk",
+			"Europe/Vienna":                  "Vienne",
+			"Europe/Warsaw":                  "Varsovie",
+			"Indian/Mauritius":               "Maurice",
+			"Pacific/Easter":                 "Île de Pâques",
+			"Pacific/Efate":                  "Éfaté",
+			"Pacific/Fiji":                   "Fidji",
+			"Pacific/Galapagos":              "Galápagos",
+			"Pacific/Honolulu":               "Honolulu",
+			"Pacific/Kanton":                 "Canton",
+			"Pacific/Marquesas":              "Marquises",
+			"Pacific/Noumea":                 "Nouméa",
+			"Pacific/Palau":                  "Palaos",
+		},
+	})
+}
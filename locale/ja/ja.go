@@ -0,0 +1,574 @@
+// Package ja registers the ja locale for zgo.at/tz, generated from CLDR.
+package ja
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "ja",
+		Countries: map[string]string{
+			"AD": "アンドラ",
+			"AE": "アラブ首長国連邦",
+			"AF": "アフガニスタン",
+			"AG": "アンティグア・バーブーダ",
+			"AI": "アンギラ",
+			"AL": "アルバニア",
+			"AM": "アルメニア",
+			"AO": "アンゴラ",
+			"AQ": "南極",
+			"AR": "アルゼンチン",
+			"AS": "米領サモア",
+			"AT": "オーストリア",
+			"AU": "オーストラリア",
+			"AW": "アルバ",
+			"AX": "オーランド諸島",
+			"AZ": "アゼルバイジャン",
+			"BA": "ボスニア・ヘルツェゴビナ",
+			"BB": "バルバドス",
+			"BD": "バングラデシュ",
+			"BE": "ベルギー",
+			"BF": "ブルキナファソ",
+			"BG": "ブルガリア",
+			"BH": "バーレーン",
+			"BI": "ブルンジ",
+			"BJ": "ベナン",
+			"BL": "サン・バルテルミー",
+			"BM": "バミューダ",
+			"BN": "ブルネイ",
+			"BO": "ボリビア",
+			"BQ": "オランダ領カリブ",
+			"BR": "ブラジル",
+			"BS": "バハマ",
+			"BT": "ブータン",
+			"BV": "ブーベ島",
+			"BW": "ボツワナ",
+			"BY": "ベラルーシ",
+			"BZ": "ベリーズ",
+			"CA": "カナダ",
+			"CC": "ココス(キーリング)諸島",
+			"CD": "コンゴ民主共和国(キンシャサ)",
+			"CF": "中央アフリカ共和国",
+			"CG": "コンゴ共和国(ブラザビル)",
+			"CH": "スイス",
+			"CI": "コートジボワール",
+			"CK": "クック諸島",
+			"CL": "チリ",
+			"CM": "カメルーン",
+			"CN": "中国",
+			"CO": "コロンビア",
+			"CR": "コスタリカ",
+			"CU": "キューバ",
+			"CV": "カーボベルデ",
+			"CW": "キュラソー",
+			"CX": "クリスマス島",
+			"CY": "キプロス",
+			"CZ": "チェコ",
+			"DE": "ドイツ",
+			"DJ": "ジブチ",
+			"DK": "デンマーク",
+			"DM": "ドミニカ国",
+			"DO": "ドミニカ共和国",
+			"DZ": "アルジェリア",
+			"EC": "エクアドル",
+			"EE": "エストニア",
+			"EG": "エジプト",
+			"EH": "西サハラ",
+			"ER": "エリトリア",
+			"ES": "スペイン",
+			"ET": "エチオピア",
+			"FI": "フィンランド",
+			"FJ": "フィジー",
+			"FK": "フォークランド諸島",
+			"FM": "ミクロネシア連邦",
+			"FO": "フェロー諸島",
+			"FR": "フランス",
+			"GA": "ガボン",
+			"GB": "イギリス",
+			"GD": "グレナダ",
+			"GE": "ジョージア",
+			"GF": "仏領ギアナ",
+			"GG": "ガーンジー",
+			"GH": "ガーナ",
+			"GI": "ジブラルタル",
+			"GL": "グリーンランド",
+			"GM": "ガンビア",
+			"GN": "ギニア",
+			"GP": "グアドループ",
+			"GQ": "赤道ギニア",
+			"GR": "ギリシャ",
+			"GS": "サウスジョージア・サウスサンドウィッチ諸島",
+			"GT": "グアテマラ",
+			"GU": "グアム",
+			"GW": "ギニアビサウ",
+			"GY": "ガイアナ",
+			"HK": "中華人民共和国香港特別行政区",
+			"HM": "ハード島・マクドナルド諸島",
+			"HN": "ホンジュラス",
+			"HR": "クロアチア",
+			"HT": "ハイチ",
+			"HU": "ハンガリー",
+			"ID": "インドネシア",
+			"IE": "アイルランド",
+			"IL": "イスラエル",
+			"IM": "マン島",
+			"IN": "インド",
+			"IO": "英領インド洋地域",
+			"IQ": "イラク",
+			"IR": "イラン",
+			"IS": "アイスランド",
+			"IT": "イタリア",
+			"JE": "ジャージー",
+			"JM": "ジャマイカ",
+			"JO": "ヨルダン",
+			"JP": "日本",
+			"KE": "ケニア",
+			"KG": "キルギス",
+			"KH": "カンボジア",
+			"KI": "キリバス",
+			"KM": "コモロ",
+			"KN": "セントクリストファー・ネーヴィス",
+			"KP": "北朝鮮",
+			"KR": "韓国",
+			"KW": "クウェート",
+			"KY": "ケイマン諸島",
+			"KZ": "カザフスタン",
+			"LA": "ラオス",
+			"LB": "レバノン",
+			"LC": "セントルシア",
+			"LI": "リヒテンシュタイン",
+			"LK": "スリランカ",
+			"LR": "リベリア",
+			"LS": "レソト",
+			"LT": "リトアニア",
+			"LU": "ルクセンブルク",
+			"LV": "ラトビア",
+			"LY": "リビア",
+			"MA": "モロッコ",
+			"MC": "モナコ",
+			"MD": "モルドバ",
+			"ME": "モンテネグロ",
+			"MF": "サン・マルタン",
+			"MG": "マダガスカル",
+			"MH": "マーシャル諸島",
+			"MK": "北マケドニア",
+			"ML": "マリ",
+			"MM": "ミャンマー (ビルマ)",
+			"MN": "モンゴル",
+			"MO": "中華人民共和国マカオ特別行政区",
+			"MP": "北マリアナ諸島",
+			"MQ": "マルティニーク",
+			"MR": "モーリタニア",
+			"MS": "モントセラト",
+			"MT": "マルタ",
+			"MU": "モーリシャス",
+			"MV": "モルディブ",
+			"MW": "マラウイ",
+			"MX": "メキシコ",
+			"MY": "マレーシア",
+			"MZ": "モザンビーク",
+			"NA": "ナミビア",
+			"NC": "ニューカレドニア",
+			"NE": "ニジェール",
+			"NF": "ノーフォーク島",
+			"NG": "ナイジェリア",
+			"NI": "ニカラグア",
+			"NL": "オランダ",
+			"NO": "ノルウェー",
+			"NP": "ネパール",
+			"NR": "ナウル",
+			"NU": "ニウエ",
+			"NZ": "ニュージーランド",
+			"OM": "オマーン",
+			"PA": "パナマ",
+			"PE": "ペルー",
+			"PF": "仏領ポリネシア",
+			"PG": "パプアニューギニア",
+			"PH": "フィリピン",
+			"PK": "パキスタン",
+			"PL": "ポーランド",
+			"PM": "サンピエール島・ミクロン島",
+			"PN": "ピトケアン諸島",
+			"PR": "プエルトリコ",
+			"PS": "パレスチナ自治区",
+			"PT": "ポルトガル",
+			"PW": "パラオ",
+			"PY": "パラグアイ",
+			"QA": "カタール",
+			"RE": "レユニオン",
+			"RO": "ルーマニア",
+			"RS": "セルビア",
+			"RU": "ロシア",
+			"RW": "ルワンダ",
+			"SA": "サウジアラビア",
+			"SB": "ソロモン諸島",
+			"SC": "セーシェル",
+			"SD": "スーダン",
+			"SE": "スウェーデン",
+			"SG": "シンガポール",
+			"SH": "セントヘレナ",
+			"SI": "スロベニア",
+			"SJ": "スバールバル諸島・ヤンマイエン島",
+			"SK": "スロバキア",
+			"SL": "シエラレオネ",
+			"SM": "サンマリノ",
+			"SN": "セネガル",
+			"SO": "ソマリア",
+			"SR": "スリナム",
+			"SS": "南スーダン",
+			"ST": "サントメ・プリンシペ",
+			"SV": "エルサルバドル",
+			"SX": "シント・マールテン",
+			"SY": "シリア",
+			"SZ": "エスワティニ",
+			"TC": "タークス・カイコス諸島",
+			"TD": "チャド",
+			"TF": "仏領極南諸島",
+			"TG": "トーゴ",
+			"TH": "タイ",
+			"TJ": "タジキスタン",
+			"TK": "トケラウ",
+			"TL": "東ティモール",
+			"TM": "トルクメニスタン",
+			"TN": "チュニジア",
+			"TO": "トンガ",
+			"TR": "トルコ",
+			"TT": "トリニダード・トバゴ",
+			"TV": "ツバル",
+			"TW": "台湾",
+			"TZ": "タンザニア",
+			"UA": "ウクライナ",
+			"UG": "ウガンダ",
+			"UM": "合衆国領有小離島",
+			"US": "アメリカ合衆国",
+			"UY": "ウルグアイ",
+			"UZ": "ウズベキスタン",
+			"VA": "バチカン市国",
+			"VC": "セントビンセント及びグレナディーン諸島",
+			"VE": "ベネズエラ",
+			"VG": "英領ヴァージン諸島",
+			"VI": "米領ヴァージン諸島",
+			"VN": "ベトナム",
+			"VU": "バヌアツ",
+			"WF": "ウォリス・フツナ",
+			"WS": "サモア",
+			"YE": "イエメン",
+			"YT": "マヨット",
+			"ZA": "南アフリカ",
+			"ZM": "ザンビア",
+			"ZW": "ジンバブエ",
+		},
+		Cities: map[string]string{
+			"Africa/Abidjan":                 "アビジャン",
+			"Africa/Algiers":                 "アルジェ",
+			"Africa/Bissau":                  "ビサウ",
+			"Africa/Cairo":                   "カイロ",
+			"Africa/Casablanca":              "カサブランカ",
+			"Africa/Ceuta":                   "セウタ",
+			"Africa/El_Aaiun":                "アイウン",
+			"Africa/Johannesburg":            "ヨハネスブルグ",
+			"Africa/Juba":                    "ジュバ",
+			"Africa/Khartoum":                "ハルツーム",
+			"Africa/Lagos":                   "ラゴス",
+			"Africa/Maputo":                  "マプト",
+			"Africa/Monrovia":                "モンロビア",
+			"Africa/Nairobi":                 "ナイロビ",
+			"Africa/Ndjamena":                "ンジャメナ",
+			"Africa/Sao_Tome":                "サントメ",
+			"Africa/Tripoli":                 "トリポリ",
+			"Africa/Tunis":                   "チュニス",
+			"Africa/Windhoek":                "ウィントフック",
+			"America/Adak":                   "アダック",
+			"America/Anchorage":              "アンカレッジ",
+			"America/Araguaina":              "アラグァイナ",
+			"America/Argentina/Buenos_Aires": "ブエノスアイレス",
+			"America/Argentina/Catamarca":    "カタマルカ",
+			"America/Argentina/Cordoba":      "コルドバ",
+			"America/Argentina/Jujuy":        "フフイ",
+			"America/Argentina/La_Rioja":     "ラリオハ",
+			"America/Argentina/Mendoza":      "メンドーサ",
+			"America/Argentina/Rio_Gallegos": "リオガジェゴス",
+			"America/Argentina/Salta":        "サルタ",
+			"America/Argentina/San_Juan":     "サンファン",
+			"America/Argentina/San_Luis":     "サンルイス",
+			"America/Argentina/Tucuman":      "トゥクマン",
+			"America/Argentina/Ushuaia":      "ウシュアイア",
+			"America/Asuncion":               "アスンシオン",
+			"America/Bahia":                  "バイーア",
+			"America/Bahia_Banderas":         "バイアバンデラ",
+			"America/Barbados":               "バルバドス",
+			"America/Belem":                  "ベレン",
+			"America/Belize":                 "ベリーズ",
+			"America/Boa_Vista":              "ボアビスタ",
+			"America/Bogota":                 "ボゴタ",
+			"America/Boise":                  "ボイシ",
+			"America/Cambridge_Bay":          "ケンブリッジベイ",
+			"America/Campo_Grande":           "カンポグランデ",
+			"America/Cancun":                 "カンクン",
+			"America/Caracas":                "カラカス",
+			"America/Cayenne":                "カイエンヌ",
+			"America/Chicago":                "シカゴ",
+			"America/Chihuahua":              "チワワ",
+			"America/Ciudad_Juarez":          "シウダー・フアレス",
+			"America/Costa_Rica":             "コスタリカ",
+			"America/Cuiaba":                 "クイアバ",
+			"America/Danmarkshavn":           "デンマークシャウン",
+			"America/Dawson":                 "ドーソン",
+			"America/Dawson_Creek":           "ドーソンクリーク",
+			"America/Denver":                 "デンバー",
+			"America/Detroit":                "デトロイト",
+			"America/Edmonton":               "エドモントン",
+			"America/Eirunepe":               "エイルネペ",
+			"America/El_Salvador":            "エルサルバドル",
+			"America/Fort_Nelson":            "フォートネルソン",
+			"America/Fortaleza":              "フォルタレザ",
+			"America/Glace_Bay":              "グレースベイ",
+			"America/Goose_Bay":              "グースベイ",
+			"America/Grand_Turk":             "グランドターク",
+			"America/Guatemala":              "グアテマラ",
+			"America/Guayaquil":              "グアヤキル",
+			"America/Guyana":                 "ガイアナ",
+			"America/Halifax":                "ハリファクス",
+			"America/Havana":                 "ハバナ",
+			"America/Hermosillo":             "エルモシヨ",
+			"America/Indiana/Indianapolis":   "インディアナポリス",
+			"America/Indiana/Knox":           "インディアナ州ノックス",
+			"America/Indiana/Marengo":        "インディアナ州マレンゴ",
+			"America/Indiana/Petersburg":     "インディアナ州ピーターズバーグ",
+			"America/Indiana/Tell_City":      "インディアナ州テルシティ",
+			"America/Indiana/Vevay":          "インディアナ州ビベー",
+			"America/Indiana/Vincennes":      "インディアナ州ビンセンス",
+			"America/Indiana/Winamac":        "インディアナ州ウィナマック",
+			"America/Inuvik":                 "イヌヴィク",
+			"America/Iqaluit":                "イカルイット",
+			"America/Jamaica":                "ジャマイカ",
+			"America/Juneau":                 "ジュノー",
+			"America/Kentucky/Louisville":    "ルイビル",
+			"America/Kentucky/Monticello":    "ケンタッキー州モンティチェロ",
+			"America/La_Paz":                 "ラパス",
+			"America/Lima":                   "リマ",
+			"America/Los_Angeles":            "ロサンゼルス",
+			"America/Maceio":                 "マセイオ",
+			"America/Managua":                "マナグア",
+			"America/Manaus":                 "マナウス",
+			"America/Martinique":             "マルティニーク",
+			"America/Matamoros":              "マタモロス",
+			"America/Mazatlan":               "マサトラン",
+			"America/Menominee":              "メノミニー",
+			"America/Merida":                 "メリダ",
+			"America/Metlakatla":             "メトラカトラ",
+			"America/Mexico_City":            "メキシコシティー",
+			"America/Miquelon":               "ミクロン島",
+			"America/Moncton":                "モンクトン",
+			"America/Monterrey":              "モンテレイ",
+			"America/Montevideo":             "モンテビデオ",
+			"America/New_York":               "ニューヨーク",
+			"America/Nome":                   "ノーム",
+			"America/Noronha":                "ノローニャ",
+			"America/North_Dakota/Beulah":    "ノースダコタ州ビューラー",
+			"America/North_Dakota/Center":    "ノースダコタ州センター",
+			"America/North_Dakota/New_Salem": "ノースダコタ州ニューセーラム",
+			"America/Nuuk":                   "ヌーク",
+			"America/Ojinaga":                "オヒナガ",
+			"America/Panama":                 "パナマ",
+			"America/Paramaribo":             "パラマリボ",
+			"America/Phoenix":                "フェニックス",
+			"America/Port-au-Prince":         "ポルトープランス",
+			"America/Porto_Velho":            "ポルトベーリョ",
+			"America/Puerto_Rico":            "プエルトリコ",
+			"America/Punta_Arenas":           "プンタアレナス",
+			"America/Rankin_Inlet":           "ランキンインレット",
+			"America/Recife":                 "レシフェ",
+			"America/Regina":                 "レジャイナ",
+			"America/Resolute":               "レゾリュート",
+			"America/Rio_Branco":             "リオブランコ",
+			"America/Santarem":               "サンタレム",
+			"America/Santiago":               "サンチアゴ",
+			"America/Santo_Domingo":          "サントドミンゴ",
+			"America/Sao_Paulo":              "サンパウロ",
+			"America/Scoresbysund":           "イトコルトルミット",
+			"America/Sitka":                  "シトカ",
+			"America/St_Johns":               "セントジョンズ",
+			"America/Swift_Current":          "スウィフトカレント",
+			"America/Tegucigalpa":            "テグシガルパ",
+			"America/Thule":                  "チューレ",
+			"America/Tijuana":                "ティフアナ",
+			"America/Toronto":                "トロント",
+			"America/Vancouver":              "バンクーバー",
+			"America/Whitehorse":             "ホワイトホース",
+			"America/Winnipeg":               "ウィニペグ",
+			"America/Yakutat":                "ヤクタット",
+			"Antarctica/Casey":               "ケーシー基地",
+			"Antarctica/Davis":               "デービス基地",
+			"Antarctica/Macquarie":           "マッコリー",
+			"Antarctica/Mawson":              "モーソン基地",
+			"Antarctica/Palmer":              "パーマー基地",
+			"Antarctica/Rothera":             "ロゼラ基地",
+			"Antarctica/Troll":               "トロル基地",
+			"Antarctica/Vostok":              "ボストーク基地",
+			"Asia/Almaty":                    "アルマトイ",
+			"Asia/Amman":                     "アンマン",
+			"Asia/Anadyr":                    "アナディリ",
+			"Asia/Aqtau":                     "アクタウ",
+			"Asia/Aqtobe":                    "アクトベ",
+			"Asia/Ashgabat":                  "アシガバード",
+			"Asia/Atyrau":                    "アティラウ",
+			"Asia/Baghdad":                   "バグダッド",
+			"Asia/Baku":                      "バクー",
+			"Asia/Bangkok":                   "バンコク",
+			"Asia/Barnaul":                   "バルナウル",
+			"Asia/Beirut":                    "ベイルート",
+			"Asia/Bishkek":                   "ビシュケク",
+			"Asia/Chita":                     "チタ",
+			"Asia/Colombo":                   "コロンボ",
+			"Asia/Damascus":                  "ダマスカス",
+			"Asia/Dhaka":                     "ダッカ",
+			"Asia/Dili":                      "ディリ",
+			"Asia/Dubai":                     "ドバイ",
+			"Asia/Dushanbe":                  "ドゥシャンベ",
+			"Asia/Famagusta":                 "ファマグスタ",
+			"Asia/Gaza":                      "ガザ",
+			"Asia/Hebron":                    "ヘブロン",
+			"Asia/Ho_Chi_Minh":               "ホーチミン",
+			"Asia/Hong_Kong":                 "香港",
+			"Asia/Hovd":                      "ホブド",
+			"Asia/Irkutsk":                   "イルクーツク",
+			"Asia/Jakarta":                   "ジャカルタ",
+			"Asia/Jayapura":                  "ジャヤプラ",
+			"Asia/Jerusalem":                 "エルサレム",
+			"Asia/Kabul":                     "カブール",
+			"Asia/Kamchatka":                 "カムチャッカ",
+			"Asia/Karachi":                   "カラチ",
+			"Asia/Kathmandu":                 "カトマンズ",
+			"Asia/Khandyga":                  "ハンドゥイガ",
+			"Asia/Kolkata":                   "コルカタ",
+			"Asia/Krasnoyarsk":               "クラスノヤルスク",
+			"Asia/Kuching":                   "クチン",
+			"Asia/Macau":                     "マカオ",
+			"Asia/Magadan":                   "マガダン",
+			"Asia/Makassar":                  "マカッサル",
+			"Asia/Manila":                    "マニラ",
+			"Asia/Nicosia":                   "ニコシア",
+			"Asia/Novokuznetsk":              "ノヴォクズネツク",
+			"Asia/Novosibirsk":               "ノヴォシビルスク",
+			"Asia/Omsk":                      "オムスク",
+			"Asia/Oral":                      "オラル",
+			"Asia/Pontianak":                 "ポンティアナック",
+			"Asia/Pyongyang":                 "平壌",
+			"Asia/Qatar":                     "カタール",
+			"Asia/Qostanay":                  "コスタナイ",
+			"Asia/Qyzylorda":                 "クズロルダ",
+			"Asia/Riyadh":                    "リヤド",
+			"Asia/Sakhalin":                  "サハリン",
+			"Asia/Samarkand":                 "サマルカンド",
+			"Asia/Seoul":                     "ソウル",
+			"Asia/Shanghai":                  "上海",
+			"Asia/Singapore":                 "シンガポール",
+			"Asia/Srednekolymsk":             "スレドネコリムスク",
+			"Asia/Taipei":                    "台北",
+			"Asia/Tashkent":                  "タシケント",
+			"Asia/Tbilisi":                   "トビリシ",
+			"Asia/Tehran":                    "テヘラン",
+			"Asia/Thimphu":                   "ティンプー",
+			"Asia/Tokyo":                     "東京",
+			"Asia/Tomsk":                     "トムスク",
+			"Asia/Ulaanbaatar":               "ウランバートル",
+			"Asia/Urumqi":                    "ウルムチ",
+			"Asia/Ust-Nera":                  "ウスチネラ",
+			"Asia/Vladivostok":               "ウラジオストク",
+			"Asia/Yakutsk":                   "ヤクーツク",
+			"Asia/Yangon":                    "ヤンゴン",
+			"Asia/Yekaterinburg":             "エカテリンブルグ",
+			"Asia/Yerevan":                   "エレバン",
+			"Atlantic/Azores":                "アゾレス",
+			"Atlantic/Bermuda":               "バミューダ",
+			"Atlantic/Canary":                "カナリア",
+			"Atlantic/Cape_Verde":            "カーボベルデ",
+			"Atlantic/Faroe":                 "フェロー",
+			"Atlantic/Madeira":               "マデイラ",
+			"Atlantic/South_Georgia":         "サウスジョージア",
+			"Atlantic/Stanley":               "スタンレー",
+			"Australia/Adelaide":             "アデレード",
+			"Australia/Brisbane":             "ブリスベン",
+			"Australia/Broken_Hill":          "ブロークンヒル",
+			"Australia/Darwin":               "ダーウィン",
+			"Australia/Eucla":                "ユークラ",
+			"Australia/Hobart":               "ホバート",
+			"Australia/Lindeman":             "リンデマン",
+			"Australia/Lord_Howe":            "ロードハウ",
+			"Australia/Melbourne":            "メルボルン",
+			"Australia/Perth":                "パース",
+			"Australia/Sydney":               "シドニー",
+			"Europe/Andorra":                 "アンドラ",
+			"Europe/Astrakhan":               "アストラハン",
+			"Europe/Athens":                  "アテネ",
+			"Europe/Belgrade":                "ベオグラード",
+			"Europe/Berlin":                  "ベルリン",
+			"Europe/Brussels":                "ブリュッセル",
+			"Europe/Bucharest":               "ブカレスト",
+			"Europe/Budapest":                "ブダペスト",
+			"Europe/Chisinau":                "キシナウ",
+			"Europe/Dublin":                  "ダブリン",
+			"Europe/Gibraltar":               "ジブラルタル",
+			"Europe/Helsinki":                "ヘルシンキ",
+			"Europe/Istanbul":                "イスタンブール",
+			"Europe/Kaliningrad":             "カリーニングラード",
+			"Europe/Kirov":                   "キーロフ",
+			"Europe/Kyiv":                    "キーウ",
+			"Europe/Lisbon":                  "リスボン",
+			"Europe/London":                  "ロンドン",
+			"Europe/Madrid":                  "マドリード",
+			"Europe/Malta":                   "マルタ",
+			"Europe/Minsk":                   "ミンスク",
+			"Europe/Moscow":                  "モスクワ",
+			"Europe/Paris":                   "パリ",
+			"Europe/Prague":                  "プラハ",
+			"Europe/Riga":                    "リガ",
+			"Europe/Rome":                    "ローマ",
+			"Europe/Samara":                  "サマラ",
+			"Europe/Saratov":                 "サラトフ",
+			"Europe/Simferopol":              "シンフェロポリ",
+			"Europe/Sofia":                   "ソフィア",
+			"Europe/Tallinn":                 "タリン",
+			"Europe/Tirane":                  "ティラナ",
+			"Europe/Ulyanovsk":               "ウリヤノフスク",
+			"Europe/Vienna":                  "ウィーン",
+			"Europe/Vilnius":                 "ヴィリニュス",
+			"Europe/Volgograd":               "ボルゴグラード",
+			"Europe/Warsaw":                  "ワルシャワ",
+			"Europe/Zurich":                  "チューリッヒ",
+			"Indian/Chagos":                  "チャゴス",
+			"Indian/Maldives":                "モルディブ",
+			"Indian/Mauritius":               "モーリシャス",
+			"Pacific/Apia":                   "アピア",
+			"Pacific/Auckland":               "オークランド",
+			"Pacific/Bougainville":           "ブーゲンビル",
+			"Pacific/Chatham":                "チャタム",
+			"Pacific/Easter":                 "イースター島",
+			"Pacific/Efate":                  "エフェテ島",
+			"Pacific/Fakaofo":                "ファカオフォ",
+			"Pacific/Fiji":                   "フィジー",
+			"Pacific/Galapagos":              "ガラパゴス",
+			"Pacific/Gambier":                "ガンビエ諸島",
+			"Pacific/Guadalcanal":            "ポンペイ島",
+			"Pacific/Guam":                   "グアム",
+			"Pacific/Honolulu":               "ホノルル",
+			"Pacific/Kanton":                 "カントン島",
+			"Pacific/Kiritimati":             "キリスィマスィ島",
+			"Pacific/Kosrae":                 "コスラエ",
+			"Pacific/Kwajalein":              "クェゼリン",
+			"Pacific/Marquesas":              "マルキーズ",
+			"Pacific/Nauru":                  "ナウル",
+			"Pacific/Niue":                   "ニウエ",
+			"Pacific/Norfolk":                "ノーフォーク島",
+			"Pacific/Noumea":                 "ヌメア",
+			"Pacific/Pago_Pago":              "パゴパゴ",
+			"Pacific/Palau":                  "パラオ",
+			"Pacific/Pitcairn":               "ピトケアン諸島",
+			"Pacific/Port_Moresby":           "チューク",
+			"Pacific/Rarotonga":              "ラロトンガ",
+			"Pacific/Tahiti":                 "タヒチ",
+			"Pacific/Tarawa":                 "タラワ",
+			"Pacific/Tongatapu":              "トンガタプ",
+		},
+	})
+}
@@ -0,0 +1,574 @@
+// Package ko registers the ko locale for zgo.at/tz, generated from CLDR.
+package ko
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "ko",
+		Countries: map[string]string{
+			"AD": "안도라",
+			"AE": "아랍에미리트",
+			"AF": "아프가니스탄",
+			"AG": "앤티가 바부다",
+			"AI": "앵귈라",
+			"AL": "알바니아",
+			"AM": "아르메니아",
+			"AO": "앙골라",
+			"AQ": "남극 대륙",
+			"AR": "아르헨티나",
+			"AS": "아메리칸 사모아",
+			"AT": "오스트리아",
+			"AU": "오스트레일리아",
+			"AW": "아루바",
+			"AX": "올란드 제도",
+			"AZ": "아제르바이잔",
+			"BA": "보스니아 헤르체고비나",
+			"BB": "바베이도스",
+			"BD": "방글라데시",
+			"BE": "벨기에",
+			"BF": "부르키나파소",
+			"BG": "불가리아",
+			"BH": "바레인",
+			"BI": "부룬디",
+			"BJ": "베냉",
+			"BL": "생바르텔레미",
+			"BM": "버뮤다",
+			"BN": "브루나이",
+			"BO": "볼리비아",
+			"BQ": "네덜란드령 카리브",
+			"BR": "브라질",
+			"BS": "바하마",
+			"BT": "부탄",
+			"BV": "부베섬",
+			"BW": "보츠와나",
+			"BY": "벨라루스",
+			"BZ": "벨리즈",
+			"CA": "캐나다",
+			"CC": "코코스 제도",
+			"CD": "콩고-킨샤사",
+			"CF": "중앙 아프리카 공화국",
+			"CG": "콩고-브라자빌",
+			"CH": "스위스",
+			"CI": "코트디부아르",
+			"CK": "쿡 제도",
+			"CL": "칠레",
+			"CM": "카메룬",
+			"CN": "중국",
+			"CO": "콜롬비아",
+			"CR": "코스타리카",
+			"CU": "쿠바",
+			"CV": "카보베르데",
+			"CW": "퀴라소",
+			"CX": "크리스마스섬",
+			"CY": "키프로스",
+			"CZ": "체코",
+			"DE": "독일",
+			"DJ": "지부티",
+			"DK": "덴마크",
+			"DM": "도미니카",
+			"DO": "도미니카 공화국",
+			"DZ": "알제리",
+			"EC": "에콰도르",
+			"EE": "에스토니아",
+			"EG": "이집트",
+			"EH": "서사하라",
+			"ER": "에리트리아",
+			"ES": "스페인",
+			"ET": "에티오피아",
+			"FI": "핀란드",
+			"FJ": "피지",
+			"FK": "포클랜드 제도",
+			"FM": "미크로네시아",
+			"FO": "페로 제도",
+			"FR": "프랑스",
+			"GA": "가봉",
+			"GB": "영국",
+			"GD": "그레나다",
+			"GE": "조지아",
+			"GF": "프랑스령 기아나",
+			"GG": "건지",
+			"GH": "가나",
+			"GI": "지브롤터",
+			"GL": "그린란드",
+			"GM": "감비아",
+			"GN": "기니",
+			"GP": "과들루프",
+			"GQ": "적도 기니",
+			"GR": "그리스",
+			"GS": "사우스조지아 사우스샌드위치 제도",
+			"GT": "과테말라",
+			"GU": "괌",
+			"GW": "기니비사우",
+			"GY": "가이아나",
+			"HK": "홍콩(중국 특별행정구)",
+			"HM": "허드 맥도널드 제도",
+			"HN": "온두라스",
+			"HR": "크로아티아",
+			"HT": "아이티",
+			"HU": "헝가리",
+			"ID": "인도네시아",
+			"IE": "아일랜드",
+			"IL": "이스라엘",
+			"IM": "맨섬",
+			"IN": "인도",
+			"IO": "영국령 인도양 지역",
+			"IQ": "이라크",
+			"IR": "이란",
+			"IS": "아이슬란드",
+			"IT": "이탈리아",
+			"JE": "저지",
+			"JM": "자메이카",
+			"JO": "요르단",
+			"JP": "일본",
+			"KE": "케냐",
+			"KG": "키르기스스탄",
+			"KH": "캄보디아",
+			"KI": "키리바시",
+			"KM": "코모로",
+			"KN": "세인트키츠 네비스",
+			"KP": "북한",
+			"KR": "대한민국",
+			"KW": "쿠웨이트",
+			"KY": "케이맨 제도",
+			"KZ": "카자흐스탄",
+			"LA": "라오스",
+			"LB": "레바논",
+			"LC": "세인트루시아",
+			"LI": "리히텐슈타인",
+			"LK": "스리랑카",
+			"LR": "라이베리아",
+			"LS": "레소토",
+			"LT": "리투아니아",
+			"LU": "룩셈부르크",
+			"LV": "라트비아",
+			"LY": "리비아",
+			"MA": "모로코",
+			"MC": "모나코",
+			"MD": "몰도바",
+			"ME": "몬테네그로",
+			"MF": "생마르탱",
+			"MG": "마다가스카르",
+			"MH": "마셜 제도",
+			"MK": "북마케도니아",
+			"ML": "말리",
+			"MM": "미얀마",
+			"MN": "몽골",
+			"MO": "마카오(중국 특별행정구)",
+			"MP": "북마리아나제도",
+			"MQ": "마르티니크",
+			"MR": "모리타니",
+			"MS": "몬트세라트",
+			"MT": "몰타",
+			"MU": "모리셔스",
+			"MV": "몰디브",
+			"MW": "말라위",
+			"MX": "멕시코",
+			"MY": "말레이시아",
+			"MZ": "모잠비크",
+			"NA": "나미비아",
+			"NC": "뉴칼레도니아",
+			"NE": "니제르",
+			"NF": "노퍽섬",
+			"NG": "나이지리아",
+			"NI": "니카라과",
+			"NL": "네덜란드",
+			"NO": "노르웨이",
+			"NP": "네팔",
+			"NR": "나우루",
+			"NU": "니우에",
+			"NZ": "뉴질랜드",
+			"OM": "오만",
+			"PA": "파나마",
+			"PE": "페루",
+			"PF": "프랑스령 폴리네시아",
+			"PG": "파푸아뉴기니",
+			"PH": "필리핀",
+			"PK": "파키스탄",
+			"PL": "폴란드",
+			"PM": "생피에르 미클롱",
+			"PN": "핏케언 제도",
+			"PR": "푸에르토리코",
+			"PS": "팔레스타인 지구",
+			"PT": "포르투갈",
+			"PW": "팔라우",
+			"PY": "파라과이",
+			"QA": "카타르",
+			"RE": "레위니옹",
+			"RO": "루마니아",
+			"RS": "세르비아",
+			"RU": "러시아",
+			"RW": "르완다",
+			"SA": "사우디아라비아",
+			"SB": "솔로몬 제도",
+			"SC": "세이셸",
+			"SD": "수단",
+			"SE": "스웨덴",
+			"SG": "싱가포르",
+			"SH": "세인트헬레나",
+			"SI": "슬로베니아",
+			"SJ": "스발바르제도-얀마웬섬",
+			"SK": "슬로바키아",
+			"SL": "시에라리온",
+			"SM": "산마리노",
+			"SN": "세네갈",
+			"SO": "소말리아",
+			"SR": "수리남",
+			"SS": "남수단",
+			"ST": "상투메 프린시페",
+			"SV": "엘살바도르",
+			"SX": "신트마르턴",
+			"SY": "시리아",
+			"SZ": "에스와티니",
+			"TC": "터크스 케이커스 제도",
+			"TD": "차드",
+			"TF": "프랑스령 남방 지역",
+			"TG": "토고",
+			"TH": "태국",
+			"TJ": "타지키스탄",
+			"TK": "토켈라우",
+			"TL": "동티모르",
+			"TM": "투르크메니스탄",
+			"TN": "튀니지",
+			"TO": "통가",
+			"TR": "튀르키예",
+			"TT": "트리니다드 토바고",
+			"TV": "투발루",
+			"TW": "대만",
+			"TZ": "탄자니아",
+			"UA": "우크라이나",
+			"UG": "우간다",
+			"UM": "미국령 해외 제도",
+			"US": "미국",
+			"UY": "우루과이",
+			"UZ": "우즈베키스탄",
+			"VA": "바티칸 시국",
+			"VC": "세인트빈센트그레나딘",
+			"VE": "베네수엘라",
+			"VG": "영국령 버진아일랜드",
+			"VI": "미국령 버진아일랜드",
+			"VN": "베트남",
+			"VU": "바누아투",
+			"WF": "왈리스-푸투나 제도",
+			"WS": "사모아",
+			"YE": "예멘",
+			"YT": "마요트",
+			"ZA": "남아프리카",
+			"ZM": "잠비아",
+			"ZW": "짐바브웨",
+		},
+		Cities: map[string]string{
+			"Africa/Abidjan":                 "아비장",
+			"Africa/Algiers":                 "알제",
+			"Africa/Bissau":                  "비사우",
+			"Africa/Cairo":                   "카이로",
+			"Africa/Casablanca":              "카사블랑카",
+			"Africa/Ceuta":                   "세우타",
+			"Africa/El_Aaiun":                "엘아이운",
+			"Africa/Johannesburg":            "요하네스버그",
+			"Africa/Juba":                    "주바",
+			"Africa/Khartoum":                "카르툼",
+			"Africa/Lagos":                   "라고스",
+			"Africa/Maputo":                  "마푸토",
+			"Africa/Monrovia":                "몬로비아",
+			"Africa/Nairobi":                 "나이로비",
+			"Africa/Ndjamena":                "엔자메나",
+			"Africa/Sao_Tome":                "상투메",
+			"Africa/Tripoli":                 "트리폴리",
+			"Africa/Tunis":                   "튀니스",
+			"Africa/Windhoek":                "빈트후크",
+			"America/Adak":                   "에이닥",
+			"America/Anchorage":              "앵커리지",
+			"America/Araguaina":              "아라과이나",
+			"America/Argentina/Buenos_Aires": "부에노스 아이레스",
+			"America/Argentina/Catamarca":    "카타마르카",
+			"America/Argentina/Cordoba":      "코르도바",
+			"America/Argentina/Jujuy":        "후후이",
+			"America/Argentina/La_Rioja":     "라 리오하",
+			"America/Argentina/Mendoza":      "멘도사",
+			"America/Argentina/Rio_Gallegos": "리오 가예고스",
+			"America/Argentina/Salta":        "살타",
+			"America/Argentina/San_Juan":     "산후안",
+			"America/Argentina/San_Luis":     "산루이스",
+			"America/Argentina/Tucuman":      "투쿠만",
+			"America/Argentina/Ushuaia":      "우수아이아",
+			"America/Asuncion":               "아순시온",
+			"America/Bahia":                  "바히아",
+			"America/Bahia_Banderas":         "바이아 반데라스",
+			"America/Barbados":               "바베이도스",
+			"America/Belem":                  "벨렘",
+			"America/Belize":                 "벨리즈",
+			"America/Boa_Vista":              "보아 비스타",
+			"America/Bogota":                 "보고타",
+			"America/Boise":                  "보이시",
+			"America/Cambridge_Bay":          "케임브리지 베이",
+			"America/Campo_Grande":           "캄포 그란데",
+			"America/Cancun":                 "칸쿤",
+			"America/Caracas":                "카라카스",
+			"America/Cayenne":                "카옌",
+			"America/Chicago":                "시카고",
+			"America/Chihuahua":              "치와와",
+			"America/Ciudad_Juarez":          "시우다드후아레스",
+			"America/Costa_Rica":             "코스타리카",
+			"America/Cuiaba":                 "쿠이아바",
+			"America/Danmarkshavn":           "덴마크샤븐",
+			"America/Dawson":                 "도슨",
+			"America/Dawson_Creek":           "도슨크릭",
+			"America/Denver":                 "덴버",
+			"America/Detroit":                "디트로이트",
+			"America/Edmonton":               "에드먼턴",
+			"America/Eirunepe":               "아이루네페",
+			"America/El_Salvador":            "엘살바도르",
+			"America/Fort_Nelson":            "포트 넬슨",
+			"America/Fortaleza":              "포르탈레자",
+			"America/Glace_Bay":              "글라스베이",
+			"America/Goose_Bay":              "구즈베이",
+			"America/Grand_Turk":             "그랜드 터크",
+			"America/Guatemala":              "과테말라",
+			"America/Guayaquil":              "과야킬",
+			"America/Guyana":                 "가이아나",
+			"America/Halifax":                "핼리팩스",
+			"America/Havana":                 "하바나",
+			"America/Hermosillo":             "에르모시요",
+			"America/Indiana/Indianapolis":   "인디애나폴리스",
+			"America/Indiana/Knox":           "인디애나주, 녹스",
+			"America/Indiana/Marengo":        "인디애나주, 머렝고",
+			"America/Indiana/Petersburg":     "인디애나주, 피츠버그",
+			"America/Indiana/Tell_City":      "인디애나주, 텔시티",
+			"America/Indiana/Vevay":          "인디애나주, 비비",
+			"America/Indiana/Vincennes":      "인디애나주, 빈센스",
+			"America/Indiana/Winamac":        "인디애나주, 위너맥",
+			"America/Inuvik":                 "이누빅",
+			"America/Iqaluit":                "이칼루이트",
+			"America/Jamaica":                "자메이카",
+			"America/Juneau":                 "주노",
+			"America/Kentucky/Louisville":    "루이빌",
+			"America/Kentucky/Monticello":    "켄터키주, 몬티첼로",
+			"America/La_Paz":                 "라파스",
+			"America/Lima":                   "리마",
+			"America/Los_Angeles":            "로스앤젤레스",
+			"America/Maceio":                 "마세이오",
+			"America/Managua":                "마나과",
+			"America/Manaus":                 "마나우스",
+			"America/Martinique":             "마티니크",
+			"America/Matamoros":              "마타모로스",
+			"America/Mazatlan":               "마사틀란",
+			"America/Menominee":              "메노미니",
+			"America/Merida":                 "메리다",
+			"America/Metlakatla":             "메틀라카틀라",
+			"America/Mexico_City":            "멕시코 시티",
+			"America/Miquelon":               "미클롱",
+			"America/Moncton":                "몽턴",
+			"America/Monterrey":              "몬테레이",
+			"America/Montevideo":             "몬테비데오",
+			"America/New_York":               "뉴욕",
+			"America/Nome":                   "놈",
+			"America/Noronha":                "노롱야",
+			"America/North_Dakota/Beulah":    "노스다코타주, 베라",
+			"America/North_Dakota/Center":    "중부, 노스다코타",
+			"America/North_Dakota/New_Salem": "노스다코타주, 뉴살렘",
+			"America/Nuuk":                   "고드호프",
+			"America/Ojinaga":                "오히나가",
+			"America/Panama":                 "파나마",
+			"America/Paramaribo":             "파라마리보",
+			"America/Phoenix":                "피닉스",
+			"America/Port-au-Prince":         "포르토프랭스",
+			"America/Porto_Velho":            "포르토벨료",
+			"America/Puerto_Rico":            "푸에르토리코",
+			"America/Punta_Arenas":           "푼타아레나스",
+			"America/Rankin_Inlet":           "랭킹 인렛",
+			"America/Recife":                 "레시페",
+			"America/Regina":                 "리자이나",
+			"America/Resolute":               "리졸루트",
+			"America/Rio_Branco":             "히우 브랑쿠",
+			"America/Santarem":               "산타렘",
+			"America/Santiago":               "산티아고",
+			"America/Santo_Domingo":          "산토도밍고",
+			"America/Sao_Paulo":              "상파울루",
+			"America/Scoresbysund":           "스코레스바이선드",
+			"America/Sitka":                  "싯카",
+			"America/St_Johns":               "세인트존스",
+			"America/Swift_Current":          "스위프트커런트",
+			"America/Tegucigalpa":            "테구시갈파",
+			"America/Thule":                  "툴레",
+			"America/Tijuana":                "티후아나",
+			"America/Toronto":                "토론토",
+			"America/Vancouver":              "벤쿠버",
+			"America/Whitehorse":             "화이트호스",
+			"America/Winnipeg":               "위니펙",
+			"America/Yakutat":                "야쿠타트",
+			"Antarctica/Casey":               "케이시",
+			"Antarctica/Davis":               "데이비스",
+			"Antarctica/Macquarie":           "맥쿼리",
+			"Antarctica/Mawson":              "모슨",
+			"Antarctica/Palmer":              "파머",
+			"Antarctica/Rothera":             "로데라",
+			"Antarctica/Troll":               "트롤",
+			"Antarctica/Vostok":              "보스토크",
+			"Asia/Almaty":                    "알마티",
+			"Asia/Amman":                     "암만",
+			"Asia/Anadyr":                    "아나디리",
+			"Asia/Aqtau":                     "아크타우",
+			"Asia/Aqtobe":                    "악토브",
+			"Asia/Ashgabat":                  "아슈하바트",
+			"Asia/Atyrau":                    "아티라우",
+			"Asia/Baghdad":                   "바그다드",
+			"Asia/Baku":                      "바쿠",
+			"Asia/Bangkok":                   "방콕",
+			"Asia/Barnaul":                   "바르나울",
+			"Asia/Beirut":                    "베이루트",
+			"Asia/Bishkek":                   "비슈케크",
+			"Asia/Chita":                     "치타",
+			"Asia/Colombo":                   "콜롬보",
+			"Asia/Damascus":                  "다마스쿠스",
+			"Asia/Dhaka":                     "다카",
+			"Asia/Dili":                      "딜리",
+			"Asia/Dubai":                     "두바이",
+			"Asia/Dushanbe":                  "두샨베",
+			"Asia/Famagusta":                 "파마구스타",
+			"Asia/Gaza":                      "가자",
+			"Asia/Hebron":                    "헤브론",
+			"Asia/Ho_Chi_Minh":               "사이공",
+			"Asia/Hong_Kong":                 "홍콩",
+			"Asia/Hovd":                      "호브드",
+			"Asia/Irkutsk":                   "이르쿠츠크",
+			"Asia/Jakarta":                   "자카르타",
+			"Asia/Jayapura":                  "자야푸라",
+			"Asia/Jerusalem":                 "예루살렘",
+			"Asia/Kabul":                     "카불",
+			"Asia/Kamchatka":                 "캄차카",
+			"Asia/Karachi":                   "카라치",
+			"Asia/Kathmandu":                 "카트만두",
+			"Asia/Khandyga":                  "한디가",
+			"Asia/Kolkata":                   "콜카타",
+			"Asia/Krasnoyarsk":               "크라스노야르스크",
+			"Asia/Kuching":                   "쿠칭",
+			"Asia/Macau":                     "마카오",
+			"Asia/Magadan":                   "마가단",
+			"Asia/Makassar":                  "마카사르",
+			"Asia/Manila":                    "마닐라",
+			"Asia/Nicosia":                   "니코시아",
+			"Asia/Novokuznetsk":              "노보쿠즈네츠크",
+			"Asia/Novosibirsk":               "노보시비르스크",
+			"Asia/Omsk":                      "옴스크",
+			"Asia/Oral":                      "오랄",
+			"Asia/Pontianak":                 "폰티아나크",
+			"Asia/Pyongyang":                 "평양",
+			"Asia/Qatar":                     "카타르",
+			"Asia/Qostanay":                  "코스타나이",
+			"Asia/Qyzylorda":                 "키질로르다",
+			"Asia/Riyadh":                    "리야드",
+			"Asia/Sakhalin":                  "사할린",
+			"Asia/Samarkand":                 "사마르칸트",
+			"Asia/Seoul":                     "서울",
+			"Asia/Shanghai":                  "상하이",
+			"Asia/Singapore":                 "싱가포르",
+			"Asia/Srednekolymsk":             "스레드네콜림스크",
+			"Asia/Taipei":                    "타이베이",
+			"Asia/Tashkent":                  "타슈켄트",
+			"Asia/Tbilisi":                   "트빌리시",
+			"Asia/Tehran":                    "테헤란",
+			"Asia/Thimphu":                   "팀부",
+			"Asia/Tokyo":                     "도쿄",
+			"Asia/Tomsk":                     "톰스크",
+			"Asia/Ulaanbaatar":               "울란바토르",
+			"Asia/Urumqi":                    "우루무치",
+			"Asia/Ust-Nera":                  "우스티네라",
+			"Asia/Vladivostok":               "블라디보스토크",
+			"Asia/Yakutsk":                   "야쿠츠크",
+			"Asia/Yangon":                    "랑군",
+			"Asia/Yekaterinburg":             "예카테린부르크",
+			"Asia/Yerevan":                   "예레반",
+			"Atlantic/Azores":                "아조레스",
+			"Atlantic/Bermuda":               "버뮤다",
+			"Atlantic/Canary":                "카나리아 제도",
+			"Atlantic/Cape_Verde":            "카보 베르데",
+			"Atlantic/Faroe":                 "페로 제도",
+			"Atlantic/Madeira":               "마데이라",
+			"Atlantic/South_Georgia":         "사우스조지아",
+			"Atlantic/Stanley":               "스탠리",
+			"Australia/Adelaide":             "애들레이드",
+			"Australia/Brisbane":             "브리스베인",
+			"Australia/Broken_Hill":          "브로컨힐",
+			"Australia/Darwin":               "다윈",
+			"Australia/Eucla":                "유클라",
+			"Australia/Hobart":               "호바트",
+			"Australia/Lindeman":             "린데만",
+			"Australia/Lord_Howe":            "로드 하우",
+			"Australia/Melbourne":            "멜버른",
+			"Australia/Perth":                "퍼스",
+			"Australia/Sydney":               "시드니",
+			"Europe/Andorra":                 "안도라",
+			"Europe/Astrakhan":               "아스트라한",
+			"Europe/Athens":                  "아테네",
+			"Europe/Belgrade":                "베오그라드",
+			"Europe/Berlin":                  "베를린",
+			"Europe/Brussels":                "브뤼셀",
+			"Europe/Bucharest":               "부쿠레슈티",
+			"Europe/Budapest":                "부다페스트",
+			"Europe/Chisinau":                "키시나우",
+			"Europe/Dublin":                  "더블린",
+			"Europe/Gibraltar":               "지브롤터",
+			"Europe/Helsinki":                "헬싱키",
+			"Europe/Istanbul":                "이스탄불",
+			"Europe/Kaliningrad":             "칼리닌그라드",
+			"Europe/Kirov":                   "키로프",
+			"Europe/Kyiv":                    "키예프",
+			"Europe/Lisbon":                  "리스본",
+			"Europe/London":                  "런던",
+			"Europe/Madrid":                  "마드리드",
+			"Europe/Malta":                   "몰타",
+			"Europe/Minsk":                   "민스크",
+			"Europe/Moscow":                  "모스크바",
+			"Europe/Paris":                   "파리",
+			"Europe/Prague":                  "프라하",
+			"Europe/Riga":                    "리가",
+			"Europe/Rome":                    "로마",
+			"Europe/Samara":                  "사마라",
+			"Europe/Saratov":                 "사라토프",
+			"Europe/Simferopol":              "심페로폴",
+			"Europe/Sofia":                   "소피아",
+			"Europe/Tallinn":                 "탈린",
+			"Europe/Tirane":                  "티라나",
+			"Europe/Ulyanovsk":               "울리야노프스크",
+			"Europe/Vienna":                  "비엔나",
+			"Europe/Vilnius":                 "빌니우스",
+			"Europe/Volgograd":               "볼고그라트",
+			"Europe/Warsaw":                  "바르샤바",
+			"Europe/Zurich":                  "취리히",
+			"Indian/Chagos":                  "차고스",
+			"Indian/Maldives":                "몰디브",
+			"Indian/Mauritius":               "모리셔스",
+			"Pacific/Apia":                   "아피아",
+			"Pacific/Auckland":               "오클랜드",
+			"Pacific/Bougainville":           "부갱빌",
+			"Pacific/Chatham":                "채텀",
+			"Pacific/Easter":                 "이스터 섬",
+			"Pacific/Efate":                  "에파테",
+			"Pacific/Fakaofo":                "파카오푸",
+			"Pacific/Fiji":                   "피지",
+			"Pacific/Galapagos":              "갈라파고스",
+			"Pacific/Gambier":                "감비어",
+			"Pacific/Guadalcanal":            "포나페",
+			"Pacific/Guam":                   "괌",
+			"Pacific/Honolulu":               "호놀룰루",
+			"Pacific/Kanton":                 "칸톤",
+			"Pacific/Kiritimati":             "키리티마티",
+			"Pacific/Kosrae":                 "코스레",
+			"Pacific/Kwajalein":              "콰잘렌",
+			"Pacific/Marquesas":              "마퀘사스",
+			"Pacific/Nauru":                  "나우루",
+			"Pacific/Niue":                   "니우에",
+			"Pacific/Norfolk":                "노퍽",
+			"Pacific/Noumea":                 "누메아",
+			"Pacific/Pago_Pago":              "파고파고",
+			"Pacific/Palau":                  "팔라우",
+			"Pacific/Pitcairn":               "핏케언",
+			"Pacific/Port_Moresby":           "트루크",
+			"Pacific/Rarotonga":              "라로통가",
+			"Pacific/Tahiti":                 "타히티",
+			"Pacific/Tarawa":                 "타라와",
+			"Pacific/Tongatapu":              "통가타푸",
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package nl_test
+
+import (
+	"testing"
+
+	"zgo.at/tz"
+	_ "zgo.at/tz/locale/nl"
+)
+
+func TestDisplayIn(t *testing.T) {
+	tests := []struct {
+		country, zone string
+		want          string
+	}{
+		{"BE", "Europe/Brussels", "België: Brussel (CEST, CET)"},
+		{"NL", "Europe/Brussels", "Nederland: Brussel (CEST, CET)"},
+		{"DE", "Europe/Berlin", "Duitsland: Berlijn (CEST, CET)"},
+		{"DK", "Europe/Berlin", "Denemarken: Berlijn (CEST, CET)"},
+		{"IN", "Asia/Kolkata", "India: Calcutta (IST)"},
+		{"ID", "Asia/Makassar", "Indonesië: Makassar (WITA)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.country+"."+tt.zone, func(t *testing.T) {
+			have := tz.MustNew(tt.country, tt.zone).DisplayIn("nl-BE")
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+// Package pl registers the pl locale for zgo.at/tz, generated from CLDR.
+package pl
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "pl",
+		Countries: map[string]string{
+			"AD": "Andora",
+			"AE": "Zjednoczone Emiraty Arabskie",
+			"AF": "Afganistan",
+			"AG": "Antigua i Barbuda",
+			"AI": "Anguilla",
+			"AL": "Albania",
+			"AM": "Armenia",
+			"AO": "Angola",
+			"AQ": "Antarktyda",
+			"AR": "Argentyna",
+			"AS": "Samoa Amerykańskie",
+			"AT": "Austria",
+			"AU": "Australia",
+			"AW": "Aruba",
+			"AX": "Wyspy Alandzkie",
+			"AZ": "Azerbejdżan",
+			"BA": "Bośnia i Hercegowina",
+			"BB": "Barbados",
+			"BD": "Bangladesz",
+			"BE": "Belgia",
+			"BF": "Burkina Faso",
+			"BG": "Bułgaria",
+			"BH": "Bahrajn",
+			"BI": "Burundi",
+			"BJ": "Benin",
+			"BL": "Saint-Barthélemy",
+			"BM": "Bermudy",
+			"BN": "Brunei",
+			"BO": "Boliwia",
+			"BQ": "Niderlandy Karaibskie",
+			"BR": "Brazylia",
+			"BS": "Bahamy",
+			"BT": "Bhutan",
+			"BV": "Wyspa Bouveta",
+			"BW": "Botswana",
+			"BY": "Białoruś",
+			"BZ": "Belize",
+			"CA": "Kanada",
+			"CC": "Wyspy Kokosowe",
+			"CD": "Demokratyczna Republika Konga",
+			"CF": "Republika Środkowoafrykańska",
+			"CG": "Kongo",
+			"CH": "Szwajcaria",
+			"CI": "Côte d’Ivoire",
+			"CK": "Wyspy Cooka",
+			"CL": "Chile",
+			"CM": "Kamerun",
+			"CN": "Chiny",
+			"CO": "Kolumbia",
+			"CR": "Kostaryka",
+			"CU": "Kuba",
+			"CV": "Republika Zielonego Przylądka",
+			"CW": "Curaçao",
+			"CX": "Wyspa Bożego Narodzenia",
+			"CY": "Cypr",
+			"CZ": "Czechy",
+			"DE": "Niemcy",
+			"DJ": "Dżibuti",
+			"DK": "Dania",
+			"DM": "Dominika",
+			"DO": "Dominikana",
+			"DZ": "Algieria",
+			"EC": "Ekwador",
+			"EE": "Estonia",
+			"EG": "Egipt",
+			"EH": "Sahara Zachodnia",
+			"ER": "Erytrea",
+			"ES": "Hiszpania",
+			"ET": "Etiopia",
+			"FI": "Finlandia",
+			"FJ": "Fidżi",
+			"FK": "Falklandy",
+			"FM": "Mikronezja",
+			"FO": "Wyspy Owcze",
+			"FR": "Francja",
+			"GA": "Gabon",
+			"GB": "Wielka Brytania",
+			"GD": "Grenada",
+			"GE": "Gruzja",
+			"GF": "Gujana Francuska",
+			"GG": "Guernsey",
+			"GH": "Ghana",
+			"GI": "Gibraltar",
+			"GL": "Grenlandia",
+			"GM": "Gambia",
+			"GN": "Gwinea",
+			"GP": "Gwadelupa",
+			"GQ": "Gwinea Równikowa",
+			"GR": "Grecja",
+			"GS": "Georgia Południowa i Sandwich Południowy",
+			"GT": "Gwatemala",
+			"GU": "Guam",
+			"GW": "Gwinea Bissau",
+			"GY": "Gujana",
+			"HK": "SRA Hongkong (Chiny)",
+			"HM": "Wyspy Heard i McDonalda",
+			"HN": "Honduras",
+			"HR": "Chorwacja",
+			"HT": "Haiti",
+			"HU": "Węgry",
+			"ID": "Indonezja",
+			"IE": "Irlandia",
+			"IL": "Izrael",
+			"IM": "Wyspa Man",
+			"IN": "Indie",
+			"IO": "Brytyjskie Terytorium Oceanu Indyjskiego",
+			"IQ": "Irak",
+			"IR": "Iran",
+			"IS": "Islandia",
+			"IT": "Włochy",
+			"JE": "Jersey",
+			"JM": "Jamajka",
+			"JO": "Jordania",
+			"JP": "Japonia",
+			"KE": "Kenia",
+			"KG": "Kirgistan",
+			"KH": "Kambodża",
+			"KI": "Kiribati",
+			"KM": "Komory",
+			"KN": "Saint Kitts i Nevis",
+			"KP": "Korea Północna",
+			"KR": "Korea Południowa",
+			"KW": "Kuwejt",
+			"KY": "Kajmany",
+			"KZ": "Kazachstan",
+			"LA": "Laos",
+			"LB": "Liban",
+			"LC": "Saint Lucia",
+			"LI": "Liechtenstein",
+			"LK": "Sri Lanka",
+			"LR": "Liberia",
+			"LS": "Lesotho",
+			"LT": "Litwa",
+			"LU": "Luksemburg",
+			"LV": "Łotwa",
+			"LY": "Libia",
+			"MA": "Maroko",
+			"MC": "Monako",
+			"MD": "Mołdawia",
+			"ME": "Czarnogóra",
+			"MF": "Saint-Martin",
+			"MG": "Madagaskar",
+			"MH": "Wyspy Marshalla",
+			"MK": "Macedonia Północna",
+			"ML": "Mali",
+			"MM": "Mjanma (Birma)",
+			"MN": "Mongolia",
+			"MO": "SRA Makau (Chiny)",
+			"MP": "Mariany Północne",
+			"MQ": "Martynika",
+			"MR": "Mauretania",
+			"MS": "Montserrat",
+			"MT": "Malta",
+			"MU": "Mauritius",
+			"MV": "Malediwy",
+			"MW": "Malawi",
+			"MX": "Meksyk",
+			"MY": "Malezja",
+			"MZ": "Mozambik",
+			"NA": "Namibia",
+			"NC": "Nowa Kaledonia",
+			"NE": "Niger",
+			"NF": "Norfolk",
+			"NG": "Nigeria",
+			"NI": "Nikaragua",
+			"NL": "Holandia",
+			"NO": "Norwegia",
+			"NP": "Nepal",
+			"NR": "Nauru",
+			"NU": "Niue",
+			"NZ": "Nowa Zelandia",
+			"OM": "Oman",
+			"PA": "Panama",
+			"PE": "Peru",
+			"PF": "Polinezja Francuska",
+			"PG": "Papua-Nowa Gwinea",
+			"PH": "Filipiny",
+			"PK": "Pakistan",
+			"PL": "Polska",
+			"PM": "Saint-Pierre i Miquelon",
+			"PN": "Pitcairn",
+			"PR": "Portoryko",
+			"PS": "Terytoria Palestyńskie",
+			"PT": "Portugalia",
+			"PW": "Palau",
+			"PY": "Paragwaj",
+			"QA": "Katar",
+			"RE": "Reunion",
+			"RO": "Rumunia",
+			"RS": "Serbia",
+			"RU": "Rosja",
+			"RW": "Rwanda",
+			"SA": "Arabia Saudyjska",
+			"SB": "Wyspy Salomona",
+			"SC": "Seszele",
+			"SD": "Sudan",
+			"SE": "Szwecja",
+			"SG": "Singapur",
+			"SH": "Wyspa Świętej Heleny",
+			"SI": "Słowenia",
+			"SJ": "Svalbard i Jan Mayen",
+			"SK": "Słowacja",
+			"SL": "Sierra Leone",
+			"SM": "San Marino",
+			"SN": "Senegal",
+			"SO": "Somalia",
+			"SR": "Surinam",
+			"SS": "Sudan Południowy",
+			"ST": "Wyspy Świętego Tomasza i Książęca",
+			"SV": "Salwador",
+			"SX": "Sint Maarten",
+			"SY": "Syria",
+			"SZ": "Eswatini",
+			"TC": "Turks i Caicos",
+			"TD": "Czad",
+			"TF": "Francuskie Terytoria Południowe i Antarktyczne",
+			"TG": "Togo",
+			"TH": "Tajlandia",
+			"TJ": "Tadżykistan",
+			"TK": "Tokelau",
+			"TL": "Timor Wschodni",
+			"TM": "Turkmenistan",
+			"TN": "Tunezja",
+			"TO": "Tonga",
+			"TR": "Turcja",
+			"TT": "Trynidad i Tobago",
+			"TV": "Tuvalu",
+			"TW": "Tajwan",
+			"TZ": "Tanzania",
+			"UA": "Ukraina",
+			"UG": "Uganda",
+			"UM": "Dalekie Wyspy Mniejsze Stanów Zjednoczonych",
+			"US": "Stany Zjednoczone",
+			"UY": "Urugwaj",
+			"UZ": "Uzbekistan",
+			"VA": "Watykan",
+			"VC": "Saint Vincent i Grenadyny",
+			"VE": "Wenezuela",
+			"VG": "Brytyjskie Wyspy Dziewicze",
+			"VI": "Wyspy Dziewicze Stanów Zjednoczonych",
+			"VN": "Wietnam",
+			"VU": "Vanuatu",
+			"WF": "Wallis i Futuna",
+			"WS": "Samoa",
+			"YE": "Jemen",
+			"YT": "Majotta",
+			"ZA": "Republika Południowej Afryki",
+			"ZM": "Zambia",
+			"ZW": "Zimbabwe",
+		},
+		Cities: map[string]string{
+			"Africa/Abidjan":                 "Abidżan",
+			"Africa/Algiers":                 "Algier",
+			"Africa/Cairo":                   "Kair",
+			"Africa/El_Aaiun":                "Al-Ujun",
+			"Africa/Juba":                    "Dżuba",
+			"Africa/Khartoum":                "Chartum",
+			"Africa/Ndjamena":                "Ndżamena",
+			"Africa/Tripoli":                 "Trypolis",
+			"Africa/Windhoek":                "Windhuk",
+			"America/Argentina/Cordoba":      "Córdoba",
+			"America/Bahia":                  "Salvador",
+			"America/Bahia_Banderas":         "Bahia Banderas",
+			"America/Belem":                  "Belém",
+			"America/Cayenne":                "Kajenna",
+			"America/Costa_Rica":             "Kostaryka",
+			"America/Cuiaba":                 "Cuiabá",
+			"America/El_Salvador":            "Salwador",
+			"America/Guatemala":              "Gwatemala",
+			"America/Guyana":                 "Gujana",
+			"America/Havana":                 "Hawana",
+			"America/Jamaica":                "Jamajka",
+			"America/Maceio":                 "Maceió",
+			"America/Martinique":             "Martynika",
+			"America/Merida":                 "Merida",
+			"America/Mexico_City":            "Meksyk (miasto)",
+			"America/New_York":               "Nowy Jork",
+			"America/North_Dakota/Beulah":    "Beulah, Dakota Północna",
+			"America/North_Dakota/Center":    "Center, Dakota Północna",
+			"America/North_Dakota/New_Salem": "New Salem, Dakota Północna",
+			"America/Puerto_Rico":            "Portoryko",
+			"America/Thule":                  "Qaanaaq",
+			"Antarctica/Vostok":              "Wostok",
+			"Asia/Almaty":                    "Ałmaty",
+			"Asia/Aqtau":                     "Aktau",
+			"Asia/Aqtobe":                    "Aktiubińsk",
+			"Asia/Ashgabat":                  "Aszchabad",
+			"Asia/Baghdad":                   "Bagdad",
+			"Asia/Barnaul":                   "Barnauł",
+			"Asia/Beirut":                    "Bejrut",
+			"Asia/Bishkek":                   "Biszkek",
+			"Asia/Chita":                     "Czyta",
+			"Asia/Colombo":                   "Kolombo",
+			"Asia/Damascus":                  "Damaszek",
+			"Asia/Dubai":                     "Dubaj",
+			"Asia/Dushanbe":                  "Duszanbe",
+			"Asia/Hong_Kong":                 "Hongkong",
+			"Asia/Hovd":                      "Kobdo",
+			"Asia/Irkutsk":                   "Irkuck",
+			"Asia/Jakarta":                   "Dżakarta",
+			"Asia/Jerusalem":                 "Jerozolima",
+			"Asia/Kamchatka":                 "Kamczatka",
+			"Asia/Karachi":                   "Karaczi",
+			"Asia/Kathmandu":                 "Katmandu",
+			"Asia/Khandyga":                  "Chandyga",
+			"Asia/Kolkata":                   "Kalkuta",
+			"Asia/Krasnoyarsk":               "Krasnojarsk",
+			"Asia/Macau":                     "Makau",
+			"Asia/Nicosia":                   "Nikozja",
+			"Asia/Novokuznetsk":              "Nowokuźnieck",
+			"Asia/Novosibirsk":               "Nowosybirsk",
+			"Asia/Oral":                      "Uralsk",
+			"Asia/Pyongyang":                 "Pjongjang",
+			"Asia/Qatar":                     "Katar",
+			"Asia/Qostanay":                  "Kustanaj",
+			"Asia/Qyzylorda":                 "Kyzyłorda",
+			"Asia/Riyadh":                    "Rijad",
+			"Asia/Sakhalin":                  "Sachalin",
+			"Asia/Samarkand":                 "Samarkanda",
+			"Asia/Seoul":                     "Seul",
+			"Asia/Shanghai":                  "Szanghaj",
+			"Asia/Singapore":                 "Singapur",
+			"Asia/Srednekolymsk":             "Sriedniekołymsk",
+			"Asia/Taipei":                    "Tajpej",
+			"Asia/Tashkent":                  "Taszkient",
+			"Asia/Tehran":                    "Teheran",
+			"Asia/Tokyo":                     "Tokio",
+			"Asia/Ulaanbaatar":               "Ułan Bator",
+			"Asia/Urumqi":                    "Urumczi",
+			"Asia/Ust-Nera":                  "Ust-Niera",
+			"Asia/Vladivostok":               "Władywostok",
+			"Asia/Yakutsk":                   "Jakuck",
+			"Asia/Yangon":                    "Rangun",
+			"Asia/Yekaterinburg":             "Jekaterynburg",
+			"Asia/Yerevan":                   "Erywań",
+			"Atlantic/Azores":                "Azory",
+			"Atlantic/Bermuda":               "Bermudy",
+			"Atlantic/Canary":                "Wyspy Kanaryjskie",
+			"Atlantic/Cape_Verde":            "Republika Zielonego Przylądka",
+			"Atlantic/Faroe":                 "Wyspy Owcze",
+			"Atlantic/Madeira":               "Madera",
+			"Atlantic/South_Georgia":         "Georgia Południowa",
+			"Europe/Andorra":                 "Andora",
+			"Europe/Astrakhan":               "Astrachań",
+			"Europe/Athens":                  "Ateny",
+			"Europe/Belgrade":                "Belgrad",
+			"Europe/Brussels":                "Bruksela",
+			"Europe/Bucharest":               "Bukareszt",
+			"Europe/Budapest":                "Budapeszt",
+			"Europe/Chisinau":                "Kiszyniów",
+			"Europe/Istanbul":                "Stambuł",
+			"Europe/Kirov":                   "Kirow",
+			"Europe/Kyiv":                    "Kijów",
+			"Europe/Lisbon":                  "Lizbona",
+			"Europe/London":                  "Londyn",
+			"Europe/Madrid":                  "Madryt",
+			"Europe/Minsk":                   "Mińsk",
+			"Europe/Moscow":                  "Moskwa",
+			"Europe/Paris":                   "Paryż",
+			"Europe/Prague":                  "Praga",
+			"Europe/Riga":                    "Ryga",
+			"Europe/Rome":                    "Rzym",
+			"Europe/Saratov":                 "Saratów",
+			"Europe/Simferopol":              "Symferopol",
+			"Europe/Tallinn":                 "Tallin",
+			"Europe/Tirane":                  "Tirana",
+			"Europe/Ulyanovsk":               "Uljanowsk",
+			"Europe/Vienna":                  "Wiedeń",
+			"Europe/Vilnius":                 "Wilno",
+			"Europe/Volgograd":               "Wołgograd",
+			"Europe/Warsaw":                  "Warszawa",
+			"Europe/Zurich":                  "Zurych",
+			"Indian/Chagos":                  "Czagos",
+			"Indian/Maldives":                "Malediwy",
+			"Pacific/Bougainville":           "Wyspa Bougainville’a",
+			"Pacific/Easter":                 "Wyspa Wielkanocna",
+			"Pacific/Fiji":                   "Fidżi",
+			"Pacific/Gambier":                "Wyspy Gambiera",
+			"Pacific/Honolulu":               "Honolulu",
+			"Pacific/Kanton":                 "Enderbury",
+			"Pacific/Marquesas":              "Markizy",
+			"Pacific/Noumea":                 "Numea",
+		},
+	})
+}
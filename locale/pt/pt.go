@@ -0,0 +1,371 @@
+// Package pt registers the pt locale for zgo.at/tz, generated from CLDR.
+package pt
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "pt",
+		Countries: map[string]string{
+			"AD": "Andorra",
+			"AE": "Emirados Árabes Unidos",
+			"AF": "Afeganistão",
+			"AG": "Antígua e Barbuda",
+			"AI": "Anguila",
+			"AL": "Albânia",
+			"AM": "Armênia",
+			"AO": "Angola",
+			"AQ": "Antártida",
+			"AR": "Argentina",
+			"AS": "Samoa Americana",
+			"AT": "Áustria",
+			"AU": "Austrália",
+			"AW": "Aruba",
+			"AX": "Ilhas Aland",
+			"AZ": "Azerbaijão",
+			"BA": "Bósnia e Herzegovina",
+			"BB": "Barbados",
+			"BD": "Bangladesh",
+			"BE": "Bélgica",
+			"BF": "Burquina Faso",
+			"BG": "Bulgária",
+			"BH": "Barein",
+			"BI": "Burundi",
+			"BJ": "Benin",
+			"BL": "São Bartolomeu",
+			"BM": "Bermudas",
+			"BN": "Brunei",
+			"BO": "Bolívia",
+			"BQ": "Países Baixos Caribenhos",
+			"BR": "Brasil",
+			"BS": "Bahamas",
+			"BT": "Butão",
+			"BV": "Ilha Bouvet",
+			"BW": "Botsuana",
+			"BY": "Bielorrússia",
+			"BZ": "Belize",
+			"CA": "Canadá",
+			"CC": "Ilhas Cocos (Keeling)",
+			"CD": "Congo - Kinshasa",
+			"CF": "República Centro-Africana",
+			"CG": "República do Congo",
+			"CH": "Suíça",
+			"CI": "Costa do Marfim",
+			"CK": "Ilhas Cook",
+			"CL": "Chile",
+			"CM": "Camarões",
+			"CN": "China",
+			"CO": "Colômbia",
+			"CR": "Costa Rica",
+			"CU": "Cuba",
+			"CV": "Cabo Verde",
+			"CW": "Curaçao",
+			"CX": "Ilha Christmas",
+			"CY": "Chipre",
+			"CZ": "Tchéquia",
+			"DE": "Alemanha",
+			"DJ": "Djibuti",
+			"DK": "Dinamarca",
+			"DM": "Dominica",
+			"DO": "República Dominicana",
+			"DZ": "Argélia",
+			"EC": "Equador",
+			"EE": "Estônia",
+			"EG": "Egito",
+			"EH": "Saara Ocidental",
+			"ER": "Eritreia",
+			"ES": "Espanha",
+			"ET": "Etiópia",
+			"FI": "Finlândia",
+			"FJ": "Fiji",
+			"FK": "Ilhas Malvinas",
+			"FM": "Micronésia",
+			"FO": "Ilhas Faroé",
+			"FR": "França",
+			"GA": "Gabão",
+			"GB": "Reino Unido",
+			"GD": "Granada",
+			"GE": "Geórgia",
+			"GF": "Guiana Francesa",
+			"GG": "Guernsey",
+			"GH": "Gana",
+			"GI": "Gibraltar",
+			"GL": "Groenlândia",
+			"GM": "Gâmbia",
+			"GN": "Guiné",
+			"GP": "Guadalupe",
+			"GQ": "Guiné Equatorial",
+			"GR": "Grécia",
+			"GS": "Ilhas Geórgia do Sul e Sandwich do Sul",
+			"GT": "Guatemala",
+			"GU": "Guam",
+			"GW": "Guiné-Bissau",
+			"GY": "Guiana",
+			"HK": "Hong Kong, RAE da China",
+			"HM": "Ilhas Heard e McDonald",
+			"HN": "Honduras",
+			"HR": "Croácia",
+			"HT": "Haiti",
+			"HU": "Hungria",
+			"ID": "Indonésia",
+			"IE": "Irlanda",
+			"IL": "Israel",
+			"IM": "Ilha de Man",
+			"IN": "Índia",
+			"IO": "Território Britânico do Oceano Índico",
+			"IQ": "Iraque",
+			"IR": "Irã",
+			"IS": "Islândia",
+			"IT": "Itália",
+			"JE": "Jersey",
+			"JM": "Jamaica",
+			"JO": "Jordânia",
+			"JP": "Japão",
+			"KE": "Quênia",
+			"KG": "Quirguistão",
+			"KH": "Camboja",
+			"KI": "Quiribati",
+			"KM": "Comores",
+			"KN": "São Cristóvão e Névis",
+			"KP": "Coreia do Norte",
+			"KR": "Coreia do Sul",
+			"KW": "Kuwait",
+			"KY": "Ilhas Cayman",
+			"KZ": "Cazaquistão",
+			"LA": "Laos",
+			"LB": "Líbano",
+			"LC": "Santa Lúcia",
+			"LI": "Liechtenstein",
+			"LK": "Sri Lanka",
+			"LR": "Libéria",
+			"LS": "Lesoto",
+			"LT": "Lituânia",
+			"LU": "Luxemburgo",
+			"LV": "Letônia",
+			"LY": "Líbia",
+			"MA": "Marrocos",
+			"MC": "Mônaco",
+			"MD": "Moldávia",
+			"ME": "Montenegro",
+			"MF": "São Martinho",
+			"MG": "Madagascar",
+			"MH": "Ilhas Marshall",
+			"MK": "Macedônia do Norte",
+			"ML": "Mali",
+			"MM": "Mianmar (Birmânia)",
+			"MN": "Mongólia",
+			"MO": "Macau, RAE da China",
+			"MP": "Ilhas Marianas do Norte",
+			"MQ": "Martinica",
+			"MR": "Mauritânia",
+			"MS": "Montserrat",
+			"MT": "Malta",
+			"MU": "Maurício",
+			"MV": "Maldivas",
+			"MW": "Malaui",
+			"MX": "México",
+			"MY": "Malásia",
+			"MZ": "Moçambique",
+			"NA": "Namíbia",
+			"NC": "Nova Caledônia",
+			"NE": "Níger",
+			"NF": "Ilha Norfolk",
+			"NG": "Nigéria",
+			"NI": "Nicarágua",
+			"NL": "Países Baixos",
+			"NO": "Noruega",
+			"NP": "Nepal",
+			"NR": "Nauru",
+			"NU": "Niue",
+			"NZ": "Nova Zelândia",
+			"OM": "Omã",
+			"PA": "Panamá",
+			"PE": "Peru",
+			"PF": "Polinésia Francesa",
+			"PG": "Papua-Nova Guiné",
+			"PH": "Filipinas",
+			"PK": "Paquistão",
+			"PL": "Polônia",
+			"PM": "São Pedro e Miquelão",
+			"PN": "Ilhas Pitcairn",
+			"PR": "Porto Rico",
+			"PS": "Territórios palestinos",
+			"PT": "Portugal",
+			"PW": "Palau",
+			"PY": "Paraguai",
+			"QA": "Catar",
+			"RE": "Reunião",
+			"RO": "Romênia",
+			"RS": "Sérvia",
+			"RU": "Rússia",
+			"RW": "Ruanda",
+			"SA": "Arábia Saudita",
+			"SB": "Ilhas Salomão",
+			"SC": "Seicheles",
+			"SD": "Sudão",
+			"SE": "Suécia",
+			"SG": "Singapura",
+			"SH": "Santa Helena",
+			"SI": "Eslovênia",
+			"SJ": "Svalbard e Jan Mayen",
+			"SK": "Eslováquia",
+			"SL": "Serra Leoa",
+			"SM": "San Marino",
+			"SN": "Senegal",
+			"SO": "Somália",
+			"SR": "Suriname",
+			"SS": "Sudão do Sul",
+			"ST": "São Tomé e Príncipe",
+			"SV": "El Salvador",
+			"SX": "Sint Maarten",
+			"SY": "Síria",
+			"SZ": "Essuatíni",
+			"TC": "Ilhas Turcas e Caicos",
+			"TD": "Chade",
+			"TF": "Territórios Franceses do Sul",
+			"TG": "Togo",
+			"TH": "Tailândia",
+			"TJ": "Tadjiquistão",
+			"TK": "Tokelau",
+			"TL": "Timor-Leste",
+			"TM": "Turcomenistão",
+			"TN": "Tunísia",
+			"TO": "Tonga",
+			"TR": "Turquia",
+			"TT": "Trinidad e Tobago",
+			"TV": "Tuvalu",
+			"TW": "Taiwan",
+			"TZ": "Tanzânia",
+			"UA": "Ucrânia",
+			"UG": "Uganda",
+			"UM": "Ilhas Menores Distantes dos EUA",
+			"US": "Estados Unidos",
+			"UY": "Uruguai",
+			"UZ": "Uzbequistão",
+			"VA": "Cidade do Vaticano",
+			"VC": "São Vicente e Granadinas",
+			"VE": "Venezuela",
+			"VG": "Ilhas Virgens Britânicas",
+			"VI": "Ilhas Virgens Americanas",
+			"VN": "Vietnã",
+			"VU": "Vanuatu",
+			"WF": "Wallis e Futuna",
+			"WS": "Samoa",
+			"YE": "Iêmen",
+			"YT": "Mayotte",
+			"ZA": "África do Sul",
+			"ZM": "Zâmbia",
+			"ZW": "Zimbábue",
+		},
+		Cities: map[string]string{
+			"Africa/Algiers":                 "Argel",
+			"Africa/El_Aaiun":                "El Aaiún",
+			"Africa/Johannesburg":            "Joanesburgo",
+			"Africa/Khartoum":                "Cartum",
+			"Africa/Monrovia":                "Monróvia",
+			"Africa/Nairobi":                 "Nairóbi",
+			"Africa/Ndjamena":                "N’Djamena",
+			"Africa/Tripoli":                 "Trípoli",
+			"Africa/Tunis":                   "Túnis",
+			"America/Araguaina":              "Araguaína",
+			"America/Argentina/Cordoba":      "Córdoba",
+			"America/Argentina/Tucuman":      "Tucumã",
+			"America/Asuncion":               "Assunção",
+			"America/Bahia_Banderas":         "Bahia de Banderas",
+			"America/Belem":                  "Belém",
+			"America/Bogota":                 "Bogotá",
+			"America/Cayenne":                "Caiena",
+			"America/Cuiaba":                 "Cuiabá",
+			"America/Eirunepe":               "Eirunepé",
+			"America/Guayaquil":              "Guaiaquil",
+			"America/Guyana":                 "Guiana",
+			"America/Indiana/Indianapolis":   "Indianápolis",
+			"America/Maceio":                 "Maceió",
+			"America/Managua":                "Manágua",
+			"America/Martinique":             "Martinica",
+			"America/Mexico_City":            "Cidade do México",
+			"America/Montevideo":             "Montevidéu",
+			"America/New_York":               "Nova York",
+			"America/Noronha":                "Fernando de Noronha",
+			"America/North_Dakota/Beulah":    "Beulah, Dakota do Norte",
+			"America/North_Dakota/Center":    "Center, Dakota do Norte",
+			"America/North_Dakota/New_Salem": "New Salen, Dakota do Norte",
+			"America/Panama":                 "Panamá",
+			"America/Port-au-Prince":         "Porto Príncipe",
+			"America/Puerto_Rico":            "Porto Rico",
+			"America/Santarem":               "Santarém",
+			"America/Sao_Paulo":              "São Paulo",
+			"America/St_Johns":               "Saint John’s",
+			"Asia/Amman":                     "Amã",
+			"Asia/Aqtau":                     "Aktau",
+			"Asia/Aqtobe":                    "Aktobe",
+			"Asia/Ashgabat":                  "Asgabate",
+			"Asia/Baghdad":                   "Bagdá",
+			"Asia/Beirut":                    "Beirute",
+			"Asia/Damascus":                  "Damasco",
+			"Asia/Dhaka":                     "Dacca",
+			"Asia/Dushanbe":                  "Duchambe",
+			"Asia/Ho_Chi_Minh":               "Cidade de Ho Chi Minh",
+			"Asia/Jakarta":                   "Jacarta",
+			"Asia/Jerusalem":                 "Jerusalém",
+			"Asia/Kabul":                     "Cabul",
+			"Asia/Kathmandu":                 "Katmandu",
+			"Asia/Kolkata":                   "Calcutá",
+			"Asia/Macau":                     "Macau",
+			"Asia/Nicosia":                   "Nicósia",
+			"Asia/Qatar":                     "Catar",
+			"Asia/Riyadh":                    "Riade",
+			"Asia/Sakhalin":                  "Sacalina",
+			"Asia/Samarkand":                 "Samarcanda",
+			"Asia/Seoul":                     "Seul",
+			"Asia/Shanghai":                  "Xangai",
+			"Asia/Singapore":                 "Singapura",
+			"Asia/Tehran":                    "Teerã",
+			"Asia/Tokyo":                     "Tóquio",
+			"Asia/Ulaanbaatar":               "Ulan Bator",
+			"Asia/Yangon":                    "Rangum",
+			"Asia/Yekaterinburg":             "Ecaterimburgo",
+			"Atlantic/Azores":                "Açores",
+			"Atlantic/Bermuda":               "Bermudas",
+			"Atlantic/Canary":                "Canárias",
+			"Atlantic/Cape_Verde":            "Cabo Verde",
+			"Atlantic/Faroe":                 "Ilhas Faroé",
+			"Atlantic/South_Georgia":         "Geórgia do Sul",
+			"Europe/Astrakhan":               "Astracã",
+			"Europe/Athens":                  "Atenas",
+			"Europe/Belgrade":                "Belgrado",
+			"Europe/Berlin":                  "Berlim",
+			"Europe/Brussels":                "Bruxelas",
+			"Europe/Bucharest":               "Bucareste",
+			"Europe/Budapest":                "Budapeste",
+			"Europe/Helsinki":                "Helsinque",
+			"Europe/Istanbul":                "Istambul",
+			"Europe/Kaliningrad":             "Kaliningrado",
+			"Europe/Kyiv":                    "Kiev",
+			"Europe/Lisbon":                  "Lisboa",
+			"Europe/London":                  "Londres",
+			"Europe/Madrid":                  "Madri",
+			"Europe/Moscow":                  "Moscou",
+			"Europe/Prague":                  "Praga",
+			"Europe/Rome":                    "Roma",
+			"Europe/Sofia":                   "Sófia",
+			"Europe/Tirane":                  "Tirana",
+			"Europe/Ulyanovsk":               "Ulianovsk",
+			"Europe/Vienna":                  "Viena",
+			"Europe/Volgograd":               "Volgogrado",
+			"Europe/Warsaw":                  "Varsóvia",
+			"Europe/Zurich":                  "Zurique",
+			"Indian/Maldives":                "Maldivas",
+			"Indian/Mauritius":               "Maurício",
+			"Pacific/Chatham":                "Chatnam",
+			"Pacific/Easter":                 "Ilha de Páscoa",
+			"Pacific/Efate":                  "Éfaté",
+			"Pacific/Galapagos":              "Galápagos",
+			"Pacific/Honolulu":               "Honolulu",
+			"Pacific/Kanton":                 "Enderbury",
+			"Pacific/Noumea":                 "Nouméa",
+			"Pacific/Tahiti":                 "Taiti",
+			"Pacific/Tarawa":                 "Taraua",
+		},
+	})
+}
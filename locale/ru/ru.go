@@ -0,0 +1,574 @@
+// Package ru registers the ru locale for zgo.at/tz, generated from CLDR.
+package ru
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "ru",
+		Countries: map[string]string{
+			"AD": "Андорра",
+			"AE": "ОАЭ",
+			"AF": "Афганистан",
+			"AG": "Антигуа и Барбуда",
+			"AI": "Ангилья",
+			"AL": "Албания",
+			"AM": "Армения",
+			"AO": "Ангола",
+			"AQ": "Антарктида",
+			"AR": "Аргентина",
+			"AS": "Американское Самоа",
+			"AT": "Австрия",
+			"AU": "Австралия",
+			"AW": "Аруба",
+			"AX": "Аландские о-ва",
+			"AZ": "Азербайджан",
+			"BA": "Босния и Герцеговина",
+			"BB": "Барбадос",
+			"BD": "Бангладеш",
+			"BE": "Бельгия",
+			"BF": "Буркина-Фасо",
+			"BG": "Болгария",
+			"BH": "Бахрейн",
+			"BI": "Бурунди",
+			"BJ": "Бенин",
+			"BL": "Сен-Бартелеми",
+			"BM": "Бермудские о-ва",
+			"BN": "Бруней",
+			"BO": "Боливия",
+			"BQ": "Бонэйр, Синт-Эстатиус и Саба",
+			"BR": "Бразилия",
+			"BS": "Багамы",
+			"BT": "Бутан",
+			"BV": "о-в Буве",
+			"BW": "Ботсвана",
+			"BY": "Беларусь",
+			"BZ": "Белиз",
+			"CA": "Канада",
+			"CC": "Кокосовые о-ва",
+			"CD": "Конго - Киншаса",
+			"CF": "Центрально-Африканская Республика",
+			"CG": "Конго - Браззавиль",
+			"CH": "Швейцария",
+			"CI": "Кот-д’Ивуар",
+			"CK": "о-ва Кука",
+			"CL": "Чили",
+			"CM": "Камерун",
+			"CN": "Китай",
+			"CO": "Колумбия",
+			"CR": "Коста-Рика",
+			"CU": "Куба",
+			"CV": "Кабо-Верде",
+			"CW": "Кюрасао",
+			"CX": "о-в Рождества",
+			"CY": "Кипр",
+			"CZ": "Чехия",
+			"DE": "Германия",
+			"DJ": "Джибути",
+			"DK": "Дания",
+			"DM": "Доминика",
+			"DO": "Доминиканская Республика",
+			"DZ": "Алжир",
+			"EC": "Эквадор",
+			"EE": "Эстония",
+			"EG": "Египет",
+			"EH": "Западная Сахара",
+			"ER": "Эритрея",
+			"ES": "Испания",
+			"ET": "Эфиопия",
+			"FI": "Финляндия",
+			"FJ": "Фиджи",
+			"FK": "Фолклендские о-ва",
+			"FM": "Федеративные Штаты Микронезии",
+			"FO": "Фарерские о-ва",
+			"FR": "Франция",
+			"GA": "Габон",
+			"GB": "Великобритания",
+			"GD": "Гренада",
+			"GE": "Грузия",
+			"GF": "Французская Гвиана",
+			"GG": "Гернси",
+			"GH": "Гана",
+			"GI": "Гибралтар",
+			"GL": "Гренландия",
+			"GM": "Гамбия",
+			"GN": "Гвинея",
+			"GP": "Гваделупа",
+			"GQ": "Экваториальная Гвинея",
+			"GR": "Греция",
+			"GS": "Южная Георгия и Южные Сандвичевы о-ва",
+			"GT": "Гватемала",
+			"GU": "Гуам",
+			"GW": "Гвинея-Бисау",
+			"GY": "Гайана",
+			"HK": "Гонконг (САР)",
+			"HM": "о-ва Херд и Макдональд",
+			"HN": "Гондурас",
+			"HR": "Хорватия",
+			"HT": "Гаити",
+			"HU": "Венгрия",
+			"ID": "Индонезия",
+			"IE": "Ирландия",
+			"IL": "Израиль",
+			"IM": "о-в Мэн",
+			"IN": "Индия",
+			"IO": "Британская территория в Индийском океане",
+			"IQ": "Ирак",
+			"IR": "Иран",
+			"IS": "Исландия",
+			"IT": "Италия",
+			"JE": "Джерси",
+			"JM": "Ямайка",
+			"JO": "Иордания",
+			"JP": "Япония",
+			"KE": "Кения",
+			"KG": "Киргизия",
+			"KH": "Камбоджа",
+			"KI": "Кирибати",
+			"KM": "Коморы",
+			"KN": "Сент-Китс и Невис",
+			"KP": "КНДР",
+			"KR": "Республика Корея",
+			"KW": "Кувейт",
+			"KY": "о-ва Кайман",
+			"KZ": "Казахстан",
+			"LA": "Лаос",
+			"LB": "Ливан",
+			"LC": "Сент-Люсия",
+			"LI": "Лихтенштейн",
+			"LK": "Шри-Ланка",
+			"LR": "Либерия",
+			"LS": "Лесото",
+			"LT": "Литва",
+			"LU": "Люксембург",
+			"LV": "Латвия",
+			"LY": "Ливия",
+			"MA": "Марокко",
+			"MC": "Монако",
+			"MD": "Молдова",
+			"ME": "Черногория",
+			"MF": "Сен-Мартен",
+			"MG": "Мадагаскар",
+			"MH": "Маршалловы о-ва",
+			"MK": "Северная Македония",
+			"ML": "Мали",
+			"MM": "Мьянма (Бирма)",
+			"MN": "Монголия",
+			"MO": "Макао (САР)",
+			"MP": "Северные Марианские о-ва",
+			"MQ": "Мартиника",
+			"MR": "Мавритания",
+			"MS": "Монтсеррат",
+			"MT": "Мальта",
+			"MU": "Маврикий",
+			"MV": "Мальдивы",
+			"MW": "Малави",
+			"MX": "Мексика",
+			"MY": "Малайзия",
+			"MZ": "Мозамбик",
+			"NA": "Намибия",
+			"NC": "Новая Каледония",
+			"NE": "Нигер",
+			"NF": "о-в Норфолк",
+			"NG": "Нигерия",
+			"NI": "Никарагуа",
+			"NL": "Нидерланды",
+			"NO": "Норвегия",
+			"NP": "Непал",
+			"NR": "Науру",
+			"NU": "Ниуэ",
+			"NZ": "Новая Зеландия",
+			"OM": "Оман",
+			"PA": "Панама",
+			"PE": "Перу",
+			"PF": "Французская Полинезия",
+			"PG": "Папуа — Новая Гвинея",
+			"PH": "Филиппины",
+			"PK": "Пакистан",
+			"PL": "Польша",
+			"PM": "Сен-Пьер и Микелон",
+			"PN": "о-ва Питкэрн",
+			"PR": "Пуэрто-Рико",
+			"PS": "Палестинские территории",
+			"PT": "Португалия",
+			"PW": "Палау",
+			"PY": "Парагвай",
+			"QA": "Катар",
+			"RE": "Реюньон",
+			"RO": "Румыния",
+			"RS": "Сербия",
+			"RU": "Россия",
+			"RW": "Руанда",
+			"SA": "Саудовская Аравия",
+			"SB": "Соломоновы о-ва",
+			"SC": "Сейшельские о-ва",
+			"SD": "Судан",
+			"SE": "Швеция",
+			"SG": "Сингапур",
+			"SH": "о-в Св. Елены",
+			"SI": "Словения",
+			"SJ": "Шпицберген и Ян-Майен",
+			"SK": "Словакия",
+			"SL": "Сьерра-Леоне",
+			"SM": "Сан-Марино",
+			"SN": "Сенегал",
+			"SO": "Сомали",
+			"SR": "Суринам",
+			"SS": "Южный Судан",
+			"ST": "Сан-Томе и Принсипи",
+			"SV": "Сальвадор",
+			"SX": "Синт-Мартен",
+			"SY": "Сирия",
+			"SZ": "Эсватини",
+			"TC": "Тёркс и Кайкос",
+			"TD": "Чад",
+			"TF": "Французские Южные территории",
+			"TG": "Того",
+			"TH": "Таиланд",
+			"TJ": "Таджикистан",
+			"TK": "Токелау",
+			"TL": "Восточный Тимор",
+			"TM": "Туркменистан",
+			"TN": "Тунис",
+			"TO": "Тонга",
+			"TR": "Турция",
+			"TT": "Тринидад и Тобаго",
+			"TV": "Тувалу",
+			"TW": "Тайвань",
+			"TZ": "Танзания",
+			"UA": "Украина",
+			"UG": "Уганда",
+			"UM": "Внешние малые о-ва (США)",
+			"US": "Соединенные Штаты",
+			"UY": "Уругвай",
+			"UZ": "Узбекистан",
+			"VA": "Ватикан",
+			"VC": "Сент-Винсент и Гренадины",
+			"VE": "Венесуэла",
+			"VG": "Виргинские о-ва (Великобритания)",
+			"VI": "Виргинские о-ва (США)",
+			"VN": "Вьетнам",
+			"VU": "Вануату",
+			"WF": "Уоллис и Футуна",
+			"WS": "Самоа",
+			"YE": "Йемен",
+			"YT": "Майотта",
+			"ZA": "Южно-Африканская Республика",
+			"ZM": "Замбия",
+			"ZW": "Зимбабве",
+		},
+		Cities: map[string]string{
+			"Africa/Abidjan":                 "Абиджан",
+			"Africa/Algiers":                 "Алжир",
+			"Africa/Bissau":                  "Бисау",
+			"Africa/Cairo":                   "Каир",
+			"Africa/Casablanca":              "Касабланка",
+			"Africa/Ceuta":                   "Сеута",
+			"Africa/El_Aaiun":                "Эль-Аюн",
+			"Africa/Johannesburg":            "Йоханнесбург",
+			"Africa/Juba":                    "Джуба",
+			"Africa/Khartoum":                "Хартум",
+			"Africa/Lagos":                   "Лагос",
+			"Africa/Maputo":                  "Мапуту",
+			"Africa/Monrovia":                "Монровия",
+			"Africa/Nairobi":                 "Найроби",
+			"Africa/Ndjamena":                "Нджамена",
+			"Africa/Sao_Tome":                "Сан-Томе",
+			"Africa/Tripoli":                 "Триполи",
+			"Africa/Tunis":                   "Тунис",
+			"Africa/Windhoek":                "Виндхук",
+			"America/Adak":                   "Адак",
+			"America/Anchorage":              "Анкоридж",
+			"America/Araguaina":              "Арагуаина",
+			"America/Argentina/Buenos_Aires": "Буэнос-Айрес",
+			"America/Argentina/Catamarca":    "Катамарка",
+			"America/Argentina/Cordoba":      "Кордова",
+			"America/Argentina/Jujuy":        "Жужуй",
+			"America/Argentina/La_Rioja":     "Ла-Риоха",
+			"America/Argentina/Mendoza":      "Мендоса",
+			"America/Argentina/Rio_Gallegos": "Рио-Гальегос",
+			"America/Argentina/Salta":        "Сальта",
+			"America/Argentina/San_Juan":     "Сан-Хуан",
+			"America/Argentina/San_Luis":     "Сан-Луис",
+			"America/Argentina/Tucuman":      "Тукуман",
+			"America/Argentina/Ushuaia":      "Ушуая",
+			"America/Asuncion":               "Асунсьон",
+			"America/Bahia":                  "Баия",
+			"America/Bahia_Banderas":         "Баия-де-Бандерас",
+			"America/Barbados":               "Барбадос",
+			"America/Belem":                  "Белен",
+			"America/Belize":                 "Белиз",
+			"America/Boa_Vista":              "Боа-Виста",
+			"America/Bogota":                 "Богота",
+			"America/Boise":                  "Бойсе",
+			"America/Cambridge_Bay":          "Кеймбридж-Бей",
+			"America/Campo_Grande":           "Кампу-Гранди",
+			"America/Cancun":                 "Канкун",
+			"America/Caracas":                "Каракас",
+			"America/Cayenne":                "Кайенна",
+			"America/Chicago":                "Чикаго",
+			"America/Chihuahua":              "Чиуауа",
+			"America/Ciudad_Juarez":          "Сьюдад-Хуарес",
+			"America/Costa_Rica":             "Коста-Рика",
+			"America/Cuiaba":                 "Куяба",
+			"America/Danmarkshavn":           "Денмарксхавн",
+			"America/Dawson":                 "Доусон",
+			"America/Dawson_Creek":           "Доусон-Крик",
+			"America/Denver":                 "Денвер",
+			"America/Detroit":                "Детройт",
+			"America/Edmonton":               "Эдмонтон",
+			"America/Eirunepe":               "Эйрунепе",
+			"America/El_Salvador":            "Сальвадор",
+			"America/Fort_Nelson":            "Форт Нельсон",
+			"America/Fortaleza":              "Форталеза",
+			"America/Glace_Bay":              "Глейс-Бей",
+			"America/Goose_Bay":              "Гус-Бей",
+			"America/Grand_Turk":             "Гранд-Терк",
+			"America/Guatemala":              "Гватемала",
+			"America/Guayaquil":              "Гуаякиль",
+			"America/Guyana":                 "Гайана",
+			"America/Halifax":                "Галифакс",
+			"America/Havana":                 "Гавана",
+			"America/Hermosillo":             "Эрмосильо",
+			"America/Indiana/Indianapolis":   "Индианаполис",
+			"America/Indiana/Knox":           "Нокс, Индиана",
+			"America/Indiana/Marengo":        "Маренго, Индиана",
+			"America/Indiana/Petersburg":     "Питерсберг, Индиана",
+			"America/Indiana/Tell_City":      "Телл-Сити",
+			"America/Indiana/Vevay":          "Вевей, Индиана",
+			"America/Indiana/Vincennes":      "Винсеннес",
+			"America/Indiana/Winamac":        "Уинамак",
+			"America/Inuvik":                 "Инувик",
+			"America/Iqaluit":                "Икалуит",
+			"America/Jamaica":                "Ямайка",
+			"America/Juneau":                 "Джуно",
+			"America/Kentucky/Louisville":    "Луисвилл",
+			"America/Kentucky/Monticello":    "Монтиселло, Кентукки",
+			"America/La_Paz":                 "Ла-Пас",
+			"America/Lima":                   "Лима",
+			"America/Los_Angeles":            "Лос-Анджелес",
+			"America/Maceio":                 "Масейо",
+			"America/Managua":                "Манагуа",
+			"America/Manaus":                 "Манаус",
+			"America/Martinique":             "Мартиника",
+			"America/Matamoros":              "Матаморос",
+			"America/Mazatlan":               "Масатлан",
+			"America/Menominee":              "Меномини",
+			"America/Merida":                 "Мерида",
+			"America/Metlakatla":             "Метлакатла",
+			"America/Mexico_City":            "Мехико",
+			"America/Miquelon":               "Микелон",
+			"America/Moncton":                "Монктон",
+			"America/Monterrey":              "Монтеррей",
+			"America/Montevideo":             "Монтевидео",
+			"America/New_York":               "Нью-Йорк",
+			"America/Nome":                   "Ном",
+			"America/Noronha":                "Норонья",
+			"America/North_Dakota/Beulah":    "Бойла, Северная Дакота",
+			"America/North_Dakota/Center":    "Центр, Северная Дакота",
+			"America/North_Dakota/New_Salem": "Нью-Сейлем, Северная Дакота",
+			"America/Nuuk":                   "Нуук",
+			"America/Ojinaga":                "Охинага",
+			"America/Panama":                 "Панама",
+			"America/Paramaribo":             "Парамарибо",
+			"America/Phoenix":                "Финикс",
+			"America/Port-au-Prince":         "Порт-о-Пренс",
+			"America/Porto_Velho":            "Порту-Велью",
+			"America/Puerto_Rico":            "Пуэрто-Рико",
+			"America/Punta_Arenas":           "Пунта-Аренас",
+			"America/Rankin_Inlet":           "Ранкин-Инлет",
+			"America/Recife":                 "Ресифи",
+			"America/Regina":                 "Реджайна",
+			"America/Resolute":               "Резольют",
+			"America/Rio_Branco":             "Риу-Бранку",
+			"America/Santarem":               "Сантарен",
+			"America/Santiago":               "Сантьяго",
+			"America/Santo_Domingo":          "Санто-Доминго",
+			"America/Sao_Paulo":              "Сан-Паулу",
+			"America/Scoresbysund":           "Скорсбисунн",
+			"America/Sitka":                  "Ситка",
+			"America/St_Johns":               "Сент-Джонс",
+			"America/Swift_Current":          "Свифт-Керрент",
+			"America/Tegucigalpa":            "Тегусигальпа",
+			"America/Thule":                  "Туле",
+			"America/Tijuana":                "Тихуана",
+			"America/Toronto":                "Торонто",
+			"America/Vancouver":              "Ванкувер",
+			"America/Whitehorse":             "Уайтхорс",
+			"America/Winnipeg":               "Виннипег",
+			"America/Yakutat":                "Якутат",
+			"Antarctica/Casey":               "Кейси",
+			"Antarctica/Davis":               "Дейвис",
+			"Antarctica/Macquarie":           "Маккуори",
+			"Antarctica/Mawson":              "Моусон",
+			"Antarctica/Palmer":              "Палмер",
+			"Antarctica/Rothera":             "Ротера",
+			"Antarctica/Troll":               "Тролль",
+			"Antarctica/Vostok":              "Восток",
+			"Asia/Almaty":                    "Алматы",
+			"Asia/Amman":                     "Амман",
+			"Asia/Anadyr":                    "Анадырь",
+			"Asia/Aqtau":                     "Актау",
+			"Asia/Aqtobe":                    "Актобе",
+			"Asia/Ashgabat":                  "Ашхабад",
+			"Asia/Atyrau":                    "Атырау",
+			"Asia/Baghdad":                   "Багдад",
+			"Asia/Baku":                      "Баку",
+			"Asia/Bangkok":                   "Бангкок",
+			"Asia/Barnaul":                   "Барнаул",
+			"Asia/Beirut":                    "Бейрут",
+			"Asia/Bishkek":                   "Бишкек",
+			"Asia/Chita":                     "Чита",
+			"Asia/Colombo":                   "Коломбо",
+			"Asia/Damascus":                  "Дамаск",
+			"Asia/Dhaka":                     "Дакка",
+			"Asia/Dili":                      "Дили",
+			"Asia/Dubai":                     "Дубай",
+			"Asia/Dushanbe":                  "Душанбе",
+			"Asia/Famagusta":                 "Фамагуста",
+			"Asia/Gaza":                      "Газа",
+			"Asia/Hebron":                    "Хеврон",
+			"Asia/Ho_Chi_Minh":               "Хошимин",
+			"Asia/Hong_Kong":                 "Гонконг",
+			"Asia/Hovd":                      "Ховд",
+			"Asia/Irkutsk":                   "Иркутск",
+			"Asia/Jakarta":                   "Джакарта",
+			"Asia/Jayapura":                  "Джаяпура",
+			"Asia/Jerusalem":                 "Иерусалим",
+			"Asia/Kabul":                     "Кабул",
+			"Asia/Kamchatka":                 "Петропавловск-Камчатский",
+			"Asia/Karachi":                   "Карачи",
+			"Asia/Kathmandu":                 "Катманду",
+			"Asia/Khandyga":                  "Хандыга",
+			"Asia/Kolkata":                   "Калькутта",
+			"Asia/Krasnoyarsk":               "Красноярск",
+			"Asia/Kuching":                   "Кучинг",
+			"Asia/Macau":                     "Макао",
+			"Asia/Magadan":                   "Магадан",
+			"Asia/Makassar":                  "Макасар",
+			"Asia/Manila":                    "Манила",
+			"Asia/Nicosia":                   "Никосия",
+			"Asia/Novokuznetsk":              "Новокузнецк",
+			"Asia/Novosibirsk":               "Новосибирск",
+			"Asia/Omsk":                      "Омск",
+			"Asia/Oral":                      "Уральск",
+			"Asia/Pontianak":                 "Понтианак",
+			"Asia/Pyongyang":                 "Пхеньян",
+			"Asia/Qatar":                     "Катар",
+			"Asia/Qostanay":                  "Костанай",
+			"Asia/Qyzylorda":                 "Кызылорда",
+			"Asia/Riyadh":                    "Эр-Рияд",
+			"Asia/Sakhalin":                  "о-в Сахалин",
+			"Asia/Samarkand":                 "Самарканд",
+			"Asia/Seoul":                     "Сеул",
+			"Asia/Shanghai":                  "Шанхай",
+			"Asia/Singapore":                 "Сингапур",
+			"Asia/Srednekolymsk":             "Среднеколымск",
+			"Asia/Taipei":                    "Тайбэй",
+			"Asia/Tashkent":                  "Ташкент",
+			"Asia/Tbilisi":                   "Тбилиси",
+			"Asia/Tehran":                    "Тегеран",
+			"Asia/Thimphu":                   "Тхимпху",
+			"Asia/Tokyo":                     "Токио",
+			"Asia/Tomsk":                     "Томск",
+			"Asia/Ulaanbaatar":               "Улан-Батор",
+			"Asia/Urumqi":                    "Урумчи",
+			"Asia/Ust-Nera":                  "Усть-Нера",
+			"Asia/Vladivostok":               "Владивосток",
+			"Asia/Yakutsk":                   "Якутск",
+			"Asia/Yangon":                    "Янгон",
+			"Asia/Yekaterinburg":             "Екатеринбург",
+			"Asia/Yerevan":                   "Ереван",
+			"Atlantic/Azores":                "Азорские о-ва",
+			"Atlantic/Bermuda":               "Бермудские о-ва",
+			"Atlantic/Canary":                "Канарские о-ва",
+			"Atlantic/Cape_Verde":            "Кабо-Верде",
+			"Atlantic/Faroe":                 "Фарерские о-ва",
+			"Atlantic/Madeira":               "Мадейра",
+			"Atlantic/South_Georgia":         "Южная Георгия",
+			"Atlantic/Stanley":               "Стэнли",
+			"Australia/Adelaide":             "Аделаида",
+			"Australia/Brisbane":             "Брисбен",
+			"Australia/Broken_Hill":          "Брокен-Хилл",
+			"Australia/Darwin":               "Дарвин",
+			"Australia/Eucla":                "Юкла",
+			"Australia/Hobart":               "Хобарт",
+			"Australia/Lindeman":             "Линдеман",
+			"Australia/Lord_Howe":            "Лорд-Хау",
+			"Australia/Melbourne":            "Мельбурн",
+			"Australia/Perth":                "Перт",
+			"Australia/Sydney":               "Сидней",
+			"Europe/Andorra":                 "Андорра",
+			"Europe/Astrakhan":               "Астрахань",
+			"Europe/Athens":                  "Афины",
+			"Europe/Belgrade":                "Белград",
+			"Europe/Berlin":                  "Берлин",
+			"Europe/Brussels":                "Брюссель",
+			"Europe/Bucharest":               "Бухарест",
+			"Europe/Budapest":                "Будапешт",
+			"Europe/Chisinau":                "Кишинев",
+			"Europe/Dublin":                  "Дублин",
+			"Europe/Gibraltar":               "Гибралтар",
+			"Europe/Helsinki":                "Хельсинки",
+			"Europe/Istanbul":                "Стамбул",
+			"Europe/Kaliningrad":             "Калининград",
+			"Europe/Kirov":                   "Киров",
+			"Europe/Kyiv":                    "Киев",
+			"Europe/Lisbon":                  "Лиссабон",
+			"Europe/London":                  "Лондон",
+			"Europe/Madrid":                  "Мадрид",
+			"Europe/Malta":                   "Мальта",
+			"Europe/Minsk":                   "Минск",
+			"Europe/Moscow":                  "Москва",
+			"Europe/Paris":                   "Париж",
+			"Europe/Prague":                  "Прага",
+			"Europe/Riga":                    "Рига",
+			"Europe/Rome":                    "Рим",
+			"Europe/Samara":                  "Самара",
+			"Europe/Saratov":                 "Саратов",
+			"Europe/Simferopol":              "Симферополь",
+			"Europe/Sofia":                   "София",
+			"Europe/Tallinn":                 "Таллин",
+			"Europe/Tirane":                  "Тирана",
+			"Europe/Ulyanovsk":               "Ульяновск",
+			"Europe/Vienna":                  "Вена",
+			"Europe/Vilnius":                 "Вильнюс",
+			"Europe/Volgograd":               "Волгоград",
+			"Europe/Warsaw":                  "Варшава",
+			"Europe/Zurich":                  "Цюрих",
+			"Indian/Chagos":                  "Чагос",
+			"Indian/Maldives":                "Мальдивы",
+			"Indian/Mauritius":               "Маврикий",
+			"Pacific/Apia":                   "Апиа",
+			"Pacific/Auckland":               "Окленд",
+			"Pacific/Bougainville":           "Бугенвиль",
+			"Pacific/Chatham":                "Чатем",
+			"Pacific/Easter":                 "о-в Пасхи",
+			"Pacific/Efate":                  "Эфате",
+			"Pacific/Fakaofo":                "Факаофо",
+			"Pacific/Fiji":                   "Фиджи",
+			"Pacific/Galapagos":              "Галапагосские о-ва",
+			"Pacific/Gambier":                "о-ва Гамбье",
+			"Pacific/Guadalcanal":            "Понпеи",
+			"Pacific/Guam":                   "Гуам",
+			"Pacific/Honolulu":               "Гонолулу",
+			"Pacific/Kanton":                 "Кантон",
+			"Pacific/Kiritimati":             "Киритимати",
+			"Pacific/Kosrae":                 "Косрае",
+			"Pacific/Kwajalein":              "Кваджалейн",
+			"Pacific/Marquesas":              "Маркизские о-ва",
+			"Pacific/Nauru":                  "Науру",
+			"Pacific/Niue":                   "Ниуэ",
+			"Pacific/Norfolk":                "Норфолк",
+			"Pacific/Noumea":                 "Нумеа",
+			"Pacific/Pago_Pago":              "Паго-Паго",
+			"Pacific/Palau":                  "Палау",
+			"Pacific/Pitcairn":               "Питкэрн",
+			"Pacific/Port_Moresby":           "Трук",
+			"Pacific/Rarotonga":              "Раротонга",
+			"Pacific/Tahiti":                 "Таити",
+			"Pacific/Tarawa":                 "Тарава",
+			"Pacific/Tongatapu":              "Тонгатапу",
+		},
+	})
+}
@@ -0,0 +1,574 @@
+// Package zh registers the zh locale for zgo.at/tz, generated from CLDR.
+package zh
+
+import "zgo.at/tz"
+
+func init() {
+	tz.RegisterLocale(&tz.Locale{
+		Lang: "zh",
+		Countries: map[string]string{
+			"AD": "安道尔",
+			"AE": "阿拉伯联合酋长国",
+			"AF": "阿富汗",
+			"AG": "安提瓜和巴布达",
+			"AI": "安圭拉",
+			"AL": "阿尔巴尼亚",
+			"AM": "亚美尼亚",
+			"AO": "安哥拉",
+			"AQ": "南极洲",
+			"AR": "阿根廷",
+			"AS": "美属萨摩亚",
+			"AT": "奥地利",
+			"AU": "澳大利亚",
+			"AW": "阿鲁巴",
+			"AX": "奥兰群岛",
+			"AZ": "阿塞拜疆",
+			"BA": "波斯尼亚和黑塞哥维那",
+			"BB": "巴巴多斯",
+			"BD": "孟加拉国",
+			"BE": "比利时",
+			"BF": "布基纳法索",
+			"BG": "保加利亚",
+			"BH": "巴林",
+			"BI": "布隆迪",
+			"BJ": "贝宁",
+			"BL": "圣巴泰勒米",
+			"BM": "百慕大",
+			"BN": "文莱",
+			"BO": "玻利维亚",
+			"BQ": "荷属加勒比区",
+			"BR": "巴西",
+			"BS": "巴哈马",
+			"BT": "不丹",
+			"BV": "布韦岛",
+			"BW": "博茨瓦纳",
+			"BY": "白俄罗斯",
+			"BZ": "伯利兹",
+			"CA": "加拿大",
+			"CC": "科科斯（基林）群岛",
+			"CD": "刚果（金）",
+			"CF": "中非共和国",
+			"CG": "刚果（布）",
+			"CH": "瑞士",
+			"CI": "科特迪瓦",
+			"CK": "库克群岛",
+			"CL": "智利",
+			"CM": "喀麦隆",
+			"CN": "中国",
+			"CO": "哥伦比亚",
+			"CR": "哥斯达黎加",
+			"CU": "古巴",
+			"CV": "佛得角",
+			"CW": "库拉索",
+			"CX": "圣诞岛",
+			"CY": "塞浦路斯",
+			"CZ": "捷克",
+			"DE": "德国",
+			"DJ": "吉布提",
+			"DK": "丹麦",
+			"DM": "多米尼克",
+			"DO": "多米尼加共和国",
+			"DZ": "阿尔及利亚",
+			"EC": "厄瓜多尔",
+			"EE": "爱沙尼亚",
+			"EG": "埃及",
+			"EH": "西撒哈拉",
+			"ER": "厄立特里亚",
+			"ES": "西班牙",
+			"ET": "埃塞俄比亚",
+			"FI": "芬兰",
+			"FJ": "斐济",
+			"FK": "福克兰群岛",
+			"FM": "密克罗尼西亚",
+			"FO": "法罗群岛",
+			"FR": "法国",
+			"GA": "加蓬",
+			"GB": "英国",
+			"GD": "格林纳达",
+			"GE": "格鲁吉亚",
+			"GF": "法属圭亚那",
+			"GG": "根西岛",
+			"GH": "加纳",
+			"GI": "直布罗陀",
+			"GL": "格陵兰",
+			"GM": "冈比亚",
+			"GN": "几内亚",
+			"GP": "瓜德罗普",
+			"GQ": "赤道几内亚",
+			"GR": "希腊",
+			"GS": "南乔治亚和南桑威奇群岛",
+			"GT": "危地马拉",
+			"GU": "关岛",
+			"GW": "几内亚比绍",
+			"GY": "圭亚那",
+			"HK": "中国香港特别行政区",
+			"HM": "赫德岛和麦克唐纳群岛",
+			"HN": "洪都拉斯",
+			"HR": "克罗地亚",
+			"HT": "海地",
+			"HU": "匈牙利",
+			"ID": "印度尼西亚",
+			"IE": "爱尔兰",
+			"IL": "以色列",
+			"IM": "马恩岛",
+			"IN": "印度",
+			"IO": "英属印度洋领地",
+			"IQ": "伊拉克",
+			"IR": "伊朗",
+			"IS": "冰岛",
+			"IT": "意大利",
+			"JE": "泽西岛",
+			"JM": "牙买加",
+			"JO": "约旦",
+			"JP": "日本",
+			"KE": "肯尼亚",
+			"KG": "吉尔吉斯斯坦",
+			"KH": "柬埔寨",
+			"KI": "基里巴斯",
+			"KM": "科摩罗",
+			"KN": "圣基茨和尼维斯",
+			"KP": "朝鲜",
+			"KR": "韩国",
+			"KW": "科威特",
+			"KY": "开曼群岛",
+			"KZ": "哈萨克斯坦",
+			"LA": "老挝",
+			"LB": "黎巴嫩",
+			"LC": "圣卢西亚",
+			"LI": "列支敦士登",
+			"LK": "斯里兰卡",
+			"LR": "利比里亚",
+			"LS": "莱索托",
+			"LT": "立陶宛",
+			"LU": "卢森堡",
+			"LV": "拉脱维亚",
+			"LY": "利比亚",
+			"MA": "摩洛哥",
+			"MC": "摩纳哥",
+			"MD": "摩尔多瓦",
+			"ME": "黑山",
+			"MF": "法属圣马丁",
+			"MG": "马达加斯加",
+			"MH": "马绍尔群岛",
+			"MK": "北马其顿",
+			"ML": "马里",
+			"MM": "缅甸",
+			"MN": "蒙古",
+			"MO": "中国澳门特别行政区",
+			"MP": "北马里亚纳群岛",
+			"MQ": "马提尼克",
+			"MR": "毛里塔尼亚",
+			"MS": "蒙特塞拉特",
+			"MT": "马耳他",
+			"MU": "毛里求斯",
+			"MV": "马尔代夫",
+			"MW": "马拉维",
+			"MX": "墨西哥",
+			"MY": "马来西亚",
+			"MZ": "莫桑比克",
+			"NA": "纳米比亚",
+			"NC": "新喀里多尼亚",
+			"NE": "尼日尔",
+			"NF": "诺福克岛",
+			"NG": "尼日利亚",
+			"NI": "尼加拉瓜",
+			"NL": "荷兰",
+			"NO": "挪威",
+			"NP": "尼泊尔",
+			"NR": "瑙鲁",
+			"NU": "纽埃",
+			"NZ": "新西兰",
+			"OM": "阿曼",
+			"PA": "巴拿马",
+			"PE": "秘鲁",
+			"PF": "法属波利尼西亚",
+			"PG": "巴布亚新几内亚",
+			"PH": "菲律宾",
+			"PK": "巴基斯坦",
+			"PL": "波兰",
+			"PM": "圣皮埃尔和密克隆群岛",
+			"PN": "皮特凯恩群岛",
+			"PR": "波多黎各",
+			"PS": "巴勒斯坦领土",
+			"PT": "葡萄牙",
+			"PW": "帕劳",
+			"PY": "巴拉圭",
+			"QA": "卡塔尔",
+			"RE": "留尼汪",
+			"RO": "罗马尼亚",
+			"RS": "塞尔维亚",
+			"RU": "俄罗斯",
+			"RW": "卢旺达",
+			"SA": "沙特阿拉伯",
+			"SB": "所罗门群岛",
+			"SC": "塞舌尔",
+			"SD": "苏丹",
+			"SE": "瑞典",
+			"SG": "新加坡",
+			"SH": "圣赫勒拿",
+			"SI": "斯洛文尼亚",
+			"SJ": "斯瓦尔巴和扬马延",
+			"SK": "斯洛伐克",
+			"SL": "塞拉利昂",
+			"SM": "圣马力诺",
+			"SN": "塞内加尔",
+			"SO": "索马里",
+			"SR": "苏里南",
+			"SS": "南苏丹",
+			"ST": "圣多美和普林西比",
+			"SV": "萨尔瓦多",
+			"SX": "荷属圣马丁",
+			"SY": "叙利亚",
+			"SZ": "斯威士兰",
+			"TC": "特克斯和凯科斯群岛",
+			"TD": "乍得",
+			"TF": "法属南部领地",
+			"TG": "多哥",
+			"TH": "泰国",
+			"TJ": "塔吉克斯坦",
+			"TK": "托克劳",
+			"TL": "东帝汶",
+			"TM": "土库曼斯坦",
+			"TN": "突尼斯",
+			"TO": "汤加",
+			"TR": "土耳其",
+			"TT": "特立尼达和多巴哥",
+			"TV": "图瓦卢",
+			"TW": "台湾",
+			"TZ": "坦桑尼亚",
+			"UA": "乌克兰",
+			"UG": "乌干达",
+			"UM": "美国本土外小岛屿",
+			"US": "美国",
+			"UY": "乌拉圭",
+			"UZ": "乌兹别克斯坦",
+			"VA": "梵蒂冈",
+			"VC": "圣文森特和格林纳丁斯",
+			"VE": "委内瑞拉",
+			"VG": "英属维尔京群岛",
+			"VI": "美属维尔京群岛",
+			"VN": "越南",
+			"VU": "瓦努阿图",
+			"WF": "瓦利斯和富图纳",
+			"WS": "萨摩亚",
+			"YE": "也门",
+			"YT": "马约特",
+			"ZA": "南非",
+			"ZM": "赞比亚",
+			"ZW": "津巴布韦",
+		},
+		Cities: map[string]string{
+			"Africa/Abidjan":                 "阿比让",
+			"Africa/Algiers":                 "阿尔及尔",
+			"Africa/Bissau":                  "比绍",
+			"Africa/Cairo":                   "开罗",
+			"Africa/Casablanca":              "卡萨布兰卡",
+			"Africa/Ceuta":                   "休达",
+			"Africa/El_Aaiun":                "阿尤恩",
+			"Africa/Johannesburg":            "约翰内斯堡",
+			"Africa/Juba":                    "朱巴",
+			"Africa/Khartoum":                "喀土穆",
+			"Africa/Lagos":                   "拉各斯",
+			"Africa/Maputo":                  "马普托",
+			"Africa/Monrovia":                "蒙罗维亚",
+			"Africa/Nairobi":                 "内罗毕",
+			"Africa/Ndjamena":                "恩贾梅纳",
+			"Africa/Sao_Tome":                "圣多美",
+			"Africa/Tripoli":                 "的黎波里",
+			"Africa/Tunis":                   "突尼斯",
+			"Africa/Windhoek":                "温得和克",
+			"America/Adak":                   "埃达克",
+			"America/Anchorage":              "安克雷奇",
+			"America/Araguaina":              "阿拉瓜伊纳",
+			"America/Argentina/Buenos_Aires": "布宜诺斯艾利斯",
+			"America/Argentina/Catamarca":    "卡塔马卡",
+			"America/Argentina/Cordoba":      "科尔多瓦",
+			"America/Argentina/Jujuy":        "胡胡伊",
+			"America/Argentina/La_Rioja":     "拉里奥哈",
+			"America/Argentina/Mendoza":      "门多萨",
+			"America/Argentina/Rio_Gallegos": "里奥加耶戈斯",
+			"America/Argentina/Salta":        "萨尔塔",
+			"America/Argentina/San_Juan":     "圣胡安",
+			"America/Argentina/San_Luis":     "圣路易斯",
+			"America/Argentina/Tucuman":      "图库曼",
+			"America/Argentina/Ushuaia":      "乌斯怀亚",
+			"America/Asuncion":               "亚松森",
+			"America/Bahia":                  "巴伊亚",
+			"America/Bahia_Banderas":         "巴伊亚班德拉斯",
+			"America/Barbados":               "巴巴多斯",
+			"America/Belem":                  "贝伦",
+			"America/Belize":                 "伯利兹",
+			"America/Boa_Vista":              "博阿维斯塔",
+			"America/Bogota":                 "波哥大",
+			"America/Boise":                  "博伊西",
+			"America/Cambridge_Bay":          "剑桥湾",
+			"America/Campo_Grande":           "大坎普",
+			"America/Cancun":                 "坎昆",
+			"America/Caracas":                "加拉加斯",
+			"America/Cayenne":                "卡宴",
+			"America/Chicago":                "芝加哥",
+			"America/Chihuahua":              "奇瓦瓦",
+			"America/Ciudad_Juarez":          "华雷斯城",
+			"America/Costa_Rica":             "哥斯达黎加",
+			"America/Cuiaba":                 "库亚巴",
+			"America/Danmarkshavn":           "丹马沙文",
+			"America/Dawson":                 "道森",
+			"America/Dawson_Creek":           "道森克里克",
+			"America/Denver":                 "丹佛",
+			"America/Detroit":                "底特律",
+			"America/Edmonton":               "埃德蒙顿",
+			"America/Eirunepe":               "依伦尼贝",
+			"America/El_Salvador":            "萨尔瓦多",
+			"America/Fort_Nelson":            "纳尔逊堡",
+			"America/Fortaleza":              "福塔雷萨",
+			"America/Glace_Bay":              "格莱斯贝",
+			"America/Goose_Bay":              "古斯湾",
+			"America/Grand_Turk":             "大特克",
+			"America/Guatemala":              "危地马拉",
+			"America/Guayaquil":              "瓜亚基尔",
+			"America/Guyana":                 "圭亚那",
+			"America/Halifax":                "哈利法克斯",
+			"America/Havana":                 "哈瓦那",
+			"America/Hermosillo":             "埃莫西约",
+			"America/Indiana/Indianapolis":   "印第安纳波利斯",
+			"America/Indiana/Knox":           "印第安纳州诺克斯",
+			"America/Indiana/Marengo":        "印第安纳州马伦戈",
+			"America/Indiana/Petersburg":     "印第安纳州彼得斯堡",
+			"America/Indiana/Tell_City":      "印第安纳州特尔城",
+			"America/Indiana/Vevay":          "印第安纳州维维市",
+			"America/Indiana/Vincennes":      "印第安纳州温森斯",
+			"America/Indiana/Winamac":        "印第安纳州威纳马克",
+			"America/Inuvik":                 "伊努维克",
+			"America/Iqaluit":                "伊魁特",
+			"America/Jamaica":                "牙买加",
+			"America/Juneau":                 "朱诺",
+			"America/Kentucky/Louisville":    "路易斯维尔",
+			"America/Kentucky/Monticello":    "肯塔基州蒙蒂塞洛",
+			"America/La_Paz":                 "拉巴斯",
+			"America/Lima":                   "利马",
+			"America/Los_Angeles":            "洛杉矶",
+			"America/Maceio":                 "马塞约",
+			"America/Managua":                "马那瓜",
+			"America/Manaus":                 "马瑙斯",
+			"America/Martinique":             "马提尼克",
+			"America/Matamoros":              "马塔莫罗斯",
+			"America/Mazatlan":               "马萨特兰",
+			"America/Menominee":              "梅诺米尼",
+			"America/Merida":                 "梅里达",
+			"America/Metlakatla":             "梅特拉卡特拉",
+			"America/Mexico_City":            "墨西哥城",
+			"America/Miquelon":               "密克隆",
+			"America/Moncton":                "蒙克顿",
+			"America/Monterrey":              "蒙特雷",
+			"America/Montevideo":             "蒙得维的亚",
+			"America/New_York":               "纽约",
+			"America/Nome":                   "诺姆",
+			"America/Noronha":                "洛罗尼亚",
+			"America/North_Dakota/Beulah":    "北达科他州比尤拉",
+			"America/North_Dakota/Center":    "北达科他州申特",
+			"America/North_Dakota/New_Salem": "北达科他州新塞勒姆",
+			"America/Nuuk":                   "努克",
+			"America/Ojinaga":                "奥希纳加",
+			"America/Panama":                 "巴拿马",
+			"America/Paramaribo":             "帕拉马里博",
+			"America/Phoenix":                "凤凰城",
+			"America/Port-au-Prince":         "太子港",
+			"America/Porto_Velho":            "波多韦柳",
+			"America/Puerto_Rico":            "波多黎各",
+			"America/Punta_Arenas":           "蓬塔阿雷纳斯",
+			"America/Rankin_Inlet":           "兰今湾",
+			"America/Recife":                 "累西腓",
+			"America/Regina":                 "里贾纳",
+			"America/Resolute":               "雷索卢特",
+			"America/Rio_Branco":             "里奥布郎库",
+			"America/Santarem":               "圣塔伦",
+			"America/Santiago":               "圣地亚哥",
+			"America/Santo_Domingo":          "圣多明各",
+			"America/Sao_Paulo":              "圣保罗",
+			"America/Scoresbysund":           "斯科列斯比桑德",
+			"America/Sitka":                  "锡特卡",
+			"America/St_Johns":               "圣约翰斯",
+			"America/Swift_Current":          "斯威夫特卡伦特",
+			"America/Tegucigalpa":            "特古西加尔巴",
+			"America/Thule":                  "图勒",
+			"America/Tijuana":                "蒂华纳",
+			"America/Toronto":                "多伦多",
+			"America/Vancouver":              "温哥华",
+			"America/Whitehorse":             "怀特霍斯",
+			"America/Winnipeg":               "温尼伯",
+			"America/Yakutat":                "亚库塔特",
+			"Antarctica/Casey":               "卡塞",
+			"Antarctica/Davis":               "戴维斯",
+			"Antarctica/Macquarie":           "麦格理",
+			"Antarctica/Mawson":              "莫森",
+			"Antarctica/Palmer":              "帕尔默",
+			"Antarctica/Rothera":             "罗瑟拉",
+			"Antarctica/Troll":               "特罗尔",
+			"Antarctica/Vostok":              "沃斯托克",
+			"Asia/Almaty":                    "阿拉木图",
+			"Asia/Amman":                     "安曼",
+			"Asia/Anadyr":                    "阿纳德尔",
+			"Asia/Aqtau":                     "阿克套",
+			"Asia/Aqtobe":                    "阿克托别",
+			"Asia/Ashgabat":                  "阿什哈巴德",
+			"Asia/Atyrau":                    "阿特劳",
+			"Asia/Baghdad":                   "巴格达",
+			"Asia/Baku":                      "巴库",
+			"Asia/Bangkok":                   "曼谷",
+			"Asia/Barnaul":                   "巴尔瑙尔",
+			"Asia/Beirut":                    "贝鲁特",
+			"Asia/Bishkek":                   "比什凯克",
+			"Asia/Chita":                     "赤塔",
+			"Asia/Colombo":                   "科伦坡",
+			"Asia/Damascus":                  "大马士革",
+			"Asia/Dhaka":                     "达卡",
+			"Asia/Dili":                      "帝力",
+			"Asia/Dubai":                     "迪拜",
+			"Asia/Dushanbe":                  "杜尚别",
+			"Asia/Famagusta":                 "法马古斯塔",
+			"Asia/Gaza":                      "加沙",
+			"Asia/Hebron":                    "希伯伦",
+			"Asia/Ho_Chi_Minh":               "胡志明市",
+			"Asia/Hong_Kong":                 "香港",
+			"Asia/Hovd":                      "科布多",
+			"Asia/Irkutsk":                   "伊尔库茨克",
+			"Asia/Jakarta":                   "雅加达",
+			"Asia/Jayapura":                  "查亚普拉",
+			"Asia/Jerusalem":                 "耶路撒冷",
+			"Asia/Kabul":                     "喀布尔",
+			"Asia/Kamchatka":                 "堪察加",
+			"Asia/Karachi":                   "卡拉奇",
+			"Asia/Kathmandu":                 "加德满都",
+			"Asia/Khandyga":                  "汉德加",
+			"Asia/Kolkata":                   "加尔各答",
+			"Asia/Krasnoyarsk":               "克拉斯诺亚尔斯克",
+			"Asia/Kuching":                   "古晋",
+			"Asia/Macau":                     "澳门",
+			"Asia/Magadan":                   "马加丹",
+			"Asia/Makassar":                  "望加锡",
+			"Asia/Manila":                    "马尼拉",
+			"Asia/Nicosia":                   "尼科西亚",
+			"Asia/Novokuznetsk":              "新库兹涅茨克",
+			"Asia/Novosibirsk":               "新西伯利亚",
+			"Asia/Omsk":                      "鄂木斯克",
+			"Asia/Oral":                      "乌拉尔",
+			"Asia/Pontianak":                 "坤甸",
+			"Asia/Pyongyang":                 "平壤",
+			"Asia/Qatar":                     "卡塔尔",
+			"Asia/Qostanay":                  "库斯塔奈",
+			"Asia/Qyzylorda":                 "克孜洛尔达",
+			"Asia/Riyadh":                    "利雅得",
+			"Asia/Sakhalin":                  "萨哈林",
+			"Asia/Samarkand":                 "撒马尔罕",
+			"Asia/Seoul":                     "首尔",
+			"Asia/Shanghai":                  "上海",
+			"Asia/Singapore":                 "新加坡",
+			"Asia/Srednekolymsk":             "中科雷姆斯克",
+			"Asia/Taipei":                    "台北",
+			"Asia/Tashkent":                  "塔什干",
+			"Asia/Tbilisi":                   "第比利斯",
+			"Asia/Tehran":                    "德黑兰",
+			"Asia/Thimphu":                   "廷布",
+			"Asia/Tokyo":                     "东京",
+			"Asia/Tomsk":                     "托木斯克",
+			"Asia/Ulaanbaatar":               "乌兰巴托",
+			"Asia/Urumqi":                    "乌鲁木齐",
+			"Asia/Ust-Nera":                  "乌斯内拉",
+			"Asia/Vladivostok":               "符拉迪沃斯托克",
+			"Asia/Yakutsk":                   "雅库茨克",
+			"Asia/Yangon":                    "仰光",
+			"Asia/Yekaterinburg":             "叶卡捷琳堡",
+			"Asia/Yerevan":                   "埃里温",
+			"Atlantic/Azores":                "亚速尔群岛",
+			"Atlantic/Bermuda":               "百慕大",
+			"Atlantic/Canary":                "加那利",
+			"Atlantic/Cape_Verde":            "佛得角",
+			"Atlantic/Faroe":                 "法罗",
+			"Atlantic/Madeira":               "马德拉",
+			"Atlantic/South_Georgia":         "南乔治亚",
+			"Atlantic/Stanley":               "斯坦利",
+			"Australia/Adelaide":             "阿德莱德",
+			"Australia/Brisbane":             "布里斯班",
+			"Australia/Broken_Hill":          "布罗肯希尔",
+			"Australia/Darwin":               "达尔文",
+			"Australia/Eucla":                "尤克拉",
+			"Australia/Hobart":               "霍巴特",
+			"Australia/Lindeman":             "林德曼",
+			"Australia/Lord_Howe":            "豪勋爵岛",
+			"Australia/Melbourne":            "墨尔本",
+			"Australia/Perth":                "珀斯",
+			"Australia/Sydney":               "悉尼",
+			"Europe/Andorra":                 "安道尔",
+			"Europe/Astrakhan":               "阿斯特拉罕",
+			"Europe/Athens":                  "雅典",
+			"Europe/Belgrade":                "贝尔格莱德",
+			"Europe/Berlin":                  "柏林",
+			"Europe/Brussels":                "布鲁塞尔",
+			"Europe/Bucharest":               "布加勒斯特",
+			"Europe/Budapest":                "布达佩斯",
+			"Europe/Chisinau":                "基希讷乌",
+			"Europe/Dublin":                  "都柏林",
+			"Europe/Gibraltar":               "直布罗陀",
+			"Europe/Helsinki":                "赫尔辛基",
+			"Europe/Istanbul":                "伊斯坦布尔",
+			"Europe/Kaliningrad":             "加里宁格勒",
+			"Europe/Kirov":                   "基洛夫",
+			"Europe/Kyiv":                    "基辅",
+			"Europe/Lisbon":                  "里斯本",
+			"Europe/London":                  "伦敦",
+			"Europe/Madrid":                  "马德里",
+			"Europe/Malta":                   "马耳他",
+			"Europe/Minsk":                   "明斯克",
+			"Europe/Moscow":                  "莫斯科",
+			"Europe/Paris":                   "巴黎",
+			"Europe/Prague":                  "布拉格",
+			"Europe/Riga":                    "里加",
+			"Europe/Rome":                    "罗马",
+			"Europe/Samara":                  "萨马拉",
+			"Europe/Saratov":                 "萨拉托夫",
+			"Europe/Simferopol":              "辛菲罗波尔",
+			"Europe/Sofia":                   "索非亚",
+			"Europe/Tallinn":                 "塔林",
+			"Europe/Tirane":                  "地拉那",
+			"Europe/Ulyanovsk":               "乌里扬诺夫斯克",
+			"Europe/Vienna":                  "维也纳",
+			"Europe/Vilnius":                 "维尔纽斯",
+			"Europe/Volgograd":               "伏尔加格勒",
+			"Europe/Warsaw":                  "华沙",
+			"Europe/Zurich":                  "苏黎世",
+			"Indian/Chagos":                  "查戈斯",
+			"Indian/Maldives":                "马尔代夫",
+			"Indian/Mauritius":               "毛里求斯",
+			"Pacific/Apia":                   "阿皮亚",
+			"Pacific/Auckland":               "奥克兰",
+			"Pacific/Bougainville":           "布干维尔",
+			"Pacific/Chatham":                "查塔姆",
+			"Pacific/Easter":                 "复活节岛",
+			"Pacific/Efate":                  "埃法特",
+			"Pacific/Fakaofo":                "法考福",
+			"Pacific/Fiji":                   "斐济",
+			"Pacific/Galapagos":              "科隆群岛",
+			"Pacific/Gambier":                "甘比尔",
+			"Pacific/Guadalcanal":            "波纳佩岛",
+			"Pacific/Guam":                   "关岛",
+			"Pacific/Honolulu":               "檀香山",
+			"Pacific/Kanton":                 "坎顿岛",
+			"Pacific/Kiritimati":             "基里地马地岛",
+			"Pacific/Kosrae":                 "库赛埃",
+			"Pacific/Kwajalein":              "夸贾林",
+			"Pacific/Marquesas":              "马克萨斯",
+			"Pacific/Nauru":                  "瑙鲁",
+			"Pacific/Niue":                   "纽埃",
+			"Pacific/Norfolk":                "诺福克",
+			"Pacific/Noumea":                 "努美阿",
+			"Pacific/Pago_Pago":              "帕果帕果",
+			"Pacific/Palau":                  "帕劳",
+			"Pacific/Pitcairn":               "皮特凯恩",
+			"Pacific/Port_Moresby":           "特鲁克群岛",
+			"Pacific/Rarotonga":              "拉罗汤加",
+			"Pacific/Tahiti":                 "塔希提",
+			"Pacific/Tarawa":                 "塔拉瓦",
+			"Pacific/Tongatapu":              "东加塔布",
+		},
+	})
+}
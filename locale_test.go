@@ -0,0 +1,49 @@
+package tz
+
+import (
+	"testing"
+)
+
+func TestLocalized(t *testing.T) {
+	RegisterLocale(&Locale{
+		Lang:      "xx",
+		Countries: map[string]string{"ID": "Indonesië", "BE": "België"},
+		Cities:    map[string]string{"Europe/Brussels": "Brussel"},
+	})
+	RegisterLocale(&Locale{
+		Lang:      "xx_YY",
+		Countries: map[string]string{"ID": "Indonesia (YY)"},
+	})
+	defer func() {
+		delete(locales, "xx")
+		delete(locales, "xx-yy")
+	}()
+
+	tests := []struct {
+		lang, zone string
+		want       string
+	}{
+		{"xx", "Asia/Makassar", "Indonesië: Makassar (WITA)"},
+		{"xx", "Europe/Brussels", "België: Brussel (CEST, CET)"},
+		{"xx", "America/Argentina/Buenos_Aires", "Argentina: Buenos Aires"},
+		{"xx-ZZ", "Asia/Makassar", "Indonesië: Makassar (WITA)"},
+		{"XX-yy", "Asia/Makassar", "Indonesia (YY): Makassar (WITA)"},
+		{"xx-YY", "Europe/Brussels", "België: Brussel (CEST, CET)"},
+		{"xx-YY-variant", "Europe/Brussels", "België: Brussel (CEST, CET)"},
+		{"zz", "Europe/Brussels", "Belgium: Brussels (CEST, CET)"},
+		{"", "Europe/Brussels", "Belgium: Brussels (CEST, CET)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+tt.zone, func(t *testing.T) {
+			have := MustNew("", tt.zone).DisplayIn(tt.lang)
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+
+	if have := (*Zone)(nil).DisplayIn("xx"); have != "" {
+		t.Errorf("not blank: %q", have)
+	}
+}
@@ -0,0 +1,196 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Status describes if a wall clock time exists in a zone.
+type Status uint8
+
+// Statuses for LocalTime().
+const (
+	Unique    Status = iota // Time exists once.
+	Ambiguous               // Time exists twice because the clock is set back, such as 02:30 when DST ends in Europe.
+	Gap                     // Time doesn't exist because the clock is set forward, such as 02:30 when DST starts in Europe.
+)
+
+func (s Status) String() string {
+	switch s {
+	case Unique:
+		return "unique"
+	case Ambiguous:
+		return "ambiguous"
+	case Gap:
+		return "gap"
+	}
+	return fmt.Sprintf("Status(%d)", s)
+}
+
+// Policy is how LocalTime() resolves ambiguous and nonexistent times.
+type Policy uint8
+
+// Policies for LocalTime().
+const (
+	// Use the time of the change for times in a gap (03:00 CEST), and the first
+	// occurrence of ambiguous times. This is the default.
+	ShiftForward Policy = iota
+
+	// Use the earlier time: the first occurrence of an ambiguous time, or for
+	// a time in a gap the wall clock time with the offset after the change
+	// (02:30 CEST is 01:30 CET).
+	Earlier
+
+	// Use the later time: the second occurrence of an ambiguous time, or for a
+	// time in a gap the wall clock time with the offset before the change
+	// (02:30 CET is 03:30 CEST).
+	Later
+
+	// Return a *LocalTimeError for ambiguous times and times in a gap.
+	Reject
+)
+
+func (p Policy) String() string {
+	switch p {
+	case ShiftForward:
+		return "shift forward"
+	case Earlier:
+		return "earlier"
+	case Later:
+		return "later"
+	case Reject:
+		return "reject"
+	}
+	return fmt.Sprintf("Policy(%d)", p)
+}
+
+// Errors for LocalTimeError.
+var (
+	ErrAmbiguous   = errors.New("ambiguous local time")
+	ErrNonexistent = errors.New("nonexistent local time")
+)
+
+// LocalTimeError is returned by LocalTime() for the Reject policy.
+type LocalTimeError struct {
+	Zone   string
+	Wall   string // Wall clock time as given: 2024-10-27 02:30:00
+	Status Status
+
+	// Both candidates; for ambiguous times these are both occurrences, and for
+	// times in a gap the wall clock time with the offset after and before the
+	// change.
+	Earlier, Later time.Time
+}
+
+func (e *LocalTimeError) Error() string {
+	return fmt.Sprintf("%s: %s in %s: %s or %s", e.Unwrap(), e.Wall, e.Zone,
+		e.Earlier.Format("15:04:05 -07:00"), e.Later.Format("15:04:05 -07:00"))
+}
+
+func (e *LocalTimeError) Unwrap() error {
+	if e.Status == Gap {
+		return ErrNonexistent
+	}
+	return ErrAmbiguous
+}
+
+// LocalTime gets the time for a wall clock time in this zone, and reports if
+// that time is unique, ambiguous, or doesn't exist. Ambiguous and nonexistent
+// times are resolved with the policy.
+//
+// time.Date() silently picks one of the options for ambiguous and nonexistent
+// times; this allows making that explicit, for example to show a warning that
+// "2024-10-27 02:30" exists twice in Europe/Amsterdam.
+//
+// The values are normalized like in time.Date(). The error is a *LocalTimeError
+// for the Reject policy, and is nil otherwise.
+func (t *Zone) LocalTime(y int, m time.Month, d, h, min, sec int, policy Policy) (time.Time, Status, error) {
+	since := time.Duration(h)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+	earlier, later, s := wallTimes(y, m, d, since, t.Loc())
+
+	if tt, ok := pick(earlier, later, s, policy); ok {
+		return tt, s, nil
+	}
+	return time.Time{}, s, &LocalTimeError{
+		Zone:    t.Loc().String(),
+		Wall:    time.Date(y, m, d, 0, 0, 0, int(since), time.UTC).Format(time.DateTime),
+		Status:  s,
+		Earlier: earlier,
+		Later:   later,
+	}
+}
+
+// pick the time for the policy; this returns false for the Reject policy if
+// the time isn't unique.
+func pick(earlier, later time.Time, s Status, policy Policy) (time.Time, bool) {
+	switch {
+	case s == Unique:
+		return earlier, true
+	case policy == Earlier:
+		return earlier, true
+	case policy == Later:
+		return later, true
+	case policy == ShiftForward && s == Gap:
+		start, _ := later.ZoneBounds()
+		return start, true
+	case policy == ShiftForward:
+		return earlier, true
+	}
+	return time.Time{}, false
+}
+
+// wallTimes gets the candidates for the wall clock time since midnight on the
+// date; earlier and later are identical for Unique times.
+func wallTimes(y int, m time.Month, d int, since time.Duration, loc *time.Location) (earlier, later time.Time, s Status) {
+	// time.Date() normalizes the nanoseconds in to the wall clock time, so this
+	// works across DST changes.
+	var (
+		t    = time.Date(y, m, d, 0, 0, 0, int(since), loc)
+		want = time.Date(y, m, d, 0, 0, 0, int(since), time.UTC)
+	)
+
+	// Try the offsets of the period t is in and the periods around it.
+	var (
+		start, end = t.ZoneBounds()
+		_, off     = t.Zone()
+		prev, next = off, off
+	)
+	if !start.IsZero() {
+		_, prev = start.Add(-1).Zone()
+	}
+	if !end.IsZero() {
+		_, next = end.Zone()
+	}
+
+	var found []time.Time
+	for _, o := range []int{prev, off, next} {
+		c := want.Add(-time.Duration(o) * time.Second).In(loc)
+		if _, cOff := c.Zone(); cOff != o {
+			continue
+		}
+		switch {
+		case len(found) == 0:
+			found = append(found, c, c)
+		case c.Before(found[0]):
+			found[0] = c
+		case c.After(found[1]):
+			found[1] = c
+		}
+	}
+
+	switch {
+	case len(found) == 0:
+		// time.Date() used either the offset before or after the change. Using
+		// the (lower) offset before the change gives the later time.
+		before, after := prev, off
+		if have := t.Add(time.Duration(off) * time.Second).UTC(); have.Before(want) {
+			before, after = off, next
+		}
+		return want.Add(-time.Duration(after) * time.Second).In(loc),
+			want.Add(-time.Duration(before) * time.Second).In(loc), Gap
+	case found[0].Equal(found[1]):
+		return found[0], found[1], Unique
+	}
+	return found[0], found[1], Ambiguous
+}
@@ -0,0 +1,95 @@
+package tz
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocalTime(t *testing.T) {
+	var (
+		bru = MustNew("", "Europe/Brussels")
+		san = MustNew("", "America/Santiago")
+		lhi = MustNew("", "Australia/Lord_Howe")
+	)
+
+	tests := []struct {
+		zone       *Zone
+		y          int
+		m          time.Month
+		d, h, min  int
+		policy     Policy
+		want       string
+		wantStatus Status
+		wantErr    error
+	}{
+		{bru, 2024, 7, 1, 10, 0, Reject, "2024-07-01 10:00:00 +0200", Unique, nil},
+		{bru, 2024, 6, 31, 10, 0, Reject, "2024-07-01 10:00:00 +0200", Unique, nil},
+		{bru, 2024, 10, 27, 1, 59, Reject, "2024-10-27 01:59:00 +0200", Unique, nil},
+		{bru, 2024, 10, 27, 3, 0, Reject, "2024-10-27 03:00:00 +0100", Unique, nil},
+		{UTC, 2024, 3, 31, 2, 30, Reject, "2024-03-31 02:30:00 +0000", Unique, nil},
+
+		{bru, 2024, 10, 27, 2, 30, Earlier, "2024-10-27 02:30:00 +0200", Ambiguous, nil},
+		{bru, 2024, 10, 27, 2, 30, Later, "2024-10-27 02:30:00 +0100", Ambiguous, nil},
+		{bru, 2024, 10, 27, 2, 30, ShiftForward, "2024-10-27 02:30:00 +0200", Ambiguous, nil},
+		{bru, 2024, 10, 27, 2, 0, Later, "2024-10-27 02:00:00 +0100", Ambiguous, nil},
+		{bru, 2024, 10, 27, 2, 30, Reject, "0001-01-01 00:00:00 +0000", Ambiguous, ErrAmbiguous},
+		{san, 2024, 4, 6, 23, 30, Earlier, "2024-04-06 23:30:00 -0300", Ambiguous, nil},
+		{san, 2024, 4, 6, 23, 30, Later, "2024-04-06 23:30:00 -0400", Ambiguous, nil},
+
+		{bru, 2024, 3, 31, 2, 30, Earlier, "2024-03-31 01:30:00 +0100", Gap, nil},
+		{bru, 2024, 3, 31, 2, 30, Later, "2024-03-31 03:30:00 +0200", Gap, nil},
+		{bru, 2024, 3, 31, 2, 30, ShiftForward, "2024-03-31 03:00:00 +0200", Gap, nil},
+		{bru, 2024, 3, 31, 2, 0, ShiftForward, "2024-03-31 03:00:00 +0200", Gap, nil},
+		{bru, 2024, 3, 31, 2, 30, Reject, "0001-01-01 00:00:00 +0000", Gap, ErrNonexistent},
+		{san, 2024, 9, 8, 0, 0, ShiftForward, "2024-09-08 01:00:00 -0300", Gap, nil},
+		{san, 2024, 9, 8, 0, 0, Later, "2024-09-08 01:00:00 -0300", Gap, nil},
+		{san, 2024, 9, 8, 0, 0, Earlier, "2024-09-07 23:00:00 -0400", Gap, nil},
+		{lhi, 2024, 10, 6, 2, 15, Later, "2024-10-06 02:45:00 +1100", Gap, nil},
+		{lhi, 2024, 10, 6, 2, 15, ShiftForward, "2024-10-06 02:30:00 +1100", Gap, nil},
+		{lhi, 2024, 4, 7, 1, 45, Later, "2024-04-07 01:45:00 +1030", Ambiguous, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			have, status, err := tt.zone.LocalTime(tt.y, tt.m, tt.d, tt.h, tt.min, 0, tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrong error\nhave: %v\nwant: %v", err, tt.wantErr)
+			}
+			if status != tt.wantStatus {
+				t.Errorf("wrong status\nhave: %s\nwant: %s", status, tt.wantStatus)
+			}
+			if h := have.Format("2006-01-02 15:04:05 -0700"); h != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", h, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalTimeError(t *testing.T) {
+	bru := MustNew("", "Europe/Brussels")
+	tests := []struct {
+		m       time.Month
+		d       int
+		wantErr string
+	}{
+		{10, 27, "ambiguous local time: 2024-10-27 02:30:00 in Europe/Brussels: 02:30:00 +02:00 or 02:30:00 +01:00"},
+		{3, 31, "nonexistent local time: 2024-03-31 02:30:00 in Europe/Brussels: 01:30:00 +01:00 or 03:30:00 +02:00"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			_, _, err := bru.LocalTime(2024, tt.m, tt.d, 2, 30, 0, Reject)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("\nhave: %v\nwant: %s", err, tt.wantErr)
+			}
+
+			var lErr *LocalTimeError
+			if !errors.As(err, &lErr) {
+				t.Fatalf("not a LocalTimeError: %T", err)
+			}
+			if d := lErr.Later.Sub(lErr.Earlier); d != time.Hour {
+				t.Errorf("difference is %s", d)
+			}
+		})
+	}
+}
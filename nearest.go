@@ -0,0 +1,89 @@
+package tz
+
+import (
+	"math"
+	"slices"
+)
+
+// Mean radius of the earth in km.
+const earthRadius = 6371.0
+
+// Nearest gets the zone with the principal location closest to the given
+// coordinates, optionally limited to the given countries.
+//
+// This is just the zone closest to the location listed in zone1970.tab (usually
+// the city in the zone name), which is not necessarily the zone that is used at
+// this location. It's a reasonable guess though, especially when combined with a
+// country.
+//
+// Returns nil if there are no zones for the countries.
+func Nearest(lat, lon float64, ccodes ...string) *Zone {
+	z := NearestN(lat, lon, 1, ccodes...)
+	if len(z) == 0 {
+		return nil
+	}
+	return z[0]
+}
+
+// NearestN gets the n zones closest to the given coordinates, optionally limited
+// to the given countries. The closest zone is first.
+//
+// A zone can be listed more than once in Zones for different countries; this
+// returns every zone name only once, with the first country for that zone
+// unless that country was filtered.
+func NearestN(lat, lon float64, n int, ccodes ...string) []*Zone {
+	if n <= 0 {
+		return nil
+	}
+	loadLocations()
+
+	type dist struct {
+		z *Zone
+		d float64
+	}
+	var (
+		dists = make([]dist, 0, 16)
+		seen  = make(map[string]struct{})
+	)
+	for _, z := range Zones {
+		if len(ccodes) > 0 && !slices.Contains(ccodes, z.CountryCode) {
+			continue
+		}
+		if _, ok := seen[z.Zone]; ok {
+			continue
+		}
+		seen[z.Zone] = struct{}{}
+		dists = append(dists, dist{z, Distance(lat, lon, z.Latitude, z.Longitude)})
+	}
+	slices.SortStableFunc(dists, func(a, b dist) int {
+		switch {
+		case a.d < b.d:
+			return -1
+		case a.d > b.d:
+			return 1
+		}
+		return 0
+	})
+
+	if n > len(dists) {
+		n = len(dists)
+	}
+	r := make([]*Zone, 0, n)
+	for _, d := range dists[:n] {
+		r = append(r, d.z)
+	}
+	return r
+}
+
+// Distance gets the great-circle distance in km between two coordinates.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	// Haversine formula.
+	var (
+		rlat1 = lat1 * math.Pi / 180
+		rlat2 = lat2 * math.Pi / 180
+		dlat  = (lat2 - lat1) * math.Pi / 180
+		dlon  = (lon2 - lon1) * math.Pi / 180
+		a     = math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(rlat1)*math.Cos(rlat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
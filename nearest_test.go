@@ -0,0 +1,56 @@
+package tz
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNearest(t *testing.T) {
+	tests := []struct {
+		lat, lon float64
+		ccodes   []string
+		want     string
+	}{
+		{52.37, 4.89, nil, "BE.Europe/Brussels"},            // Amsterdam
+		{52.37, 4.89, []string{"NL"}, "NL.Europe/Brussels"}, // Amsterdam
+		{-8.65, 115.22, nil, "ID.Asia/Makassar"},            // Denpasar
+		{-8.65, 115.22, []string{"AU"}, "AU.Australia/Darwin"},
+		{52.37, 4.89, []string{"XX"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v,%v", tt.lat, tt.lon), func(t *testing.T) {
+			have := Nearest(tt.lat, tt.lon, tt.ccodes...).String()
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestNearestN(t *testing.T) {
+	// Denpasar
+	have := fmt.Sprint(NearestN(-8.65, 115.22, 3))
+	want := "[ID.Asia/Makassar ID.Asia/Jakarta TL.Asia/Dili]"
+	if have != want {
+		t.Errorf("\nhave: %s\nwant: %s", have, want)
+	}
+
+	if have := NearestN(-8.65, 115.22, 0); have != nil {
+		t.Errorf("not nil: %v", have)
+	}
+	if have := len(NearestN(-8.65, 115.22, 10, "ID")); have != 4 {
+		t.Errorf("len is %d", have)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// Amsterdam → Makassar is about 12,100km.
+	have := Distance(52.37, 4.89, -5.14, 119.42)
+	if have < 12000 || have > 12200 {
+		t.Errorf("have: %f", have)
+	}
+	if have := Distance(52.37, 4.89, 52.37, 4.89); have != 0 {
+		t.Errorf("have: %f", have)
+	}
+}
@@ -0,0 +1,125 @@
+package tz
+
+import (
+	"time"
+)
+
+// Interval is a period of time, from Start up to (but not including) End.
+type Interval struct {
+	Start, End time.Time
+}
+
+// Duration gets the length of the interval.
+func (i Interval) Duration() time.Duration { return i.End.Sub(i.Start) }
+
+// Hours are the working hours for a zone, as the wall clock time since local
+// midnight: 9*time.Hour is 09:00, regardless of any DST changes. End may be more
+// than 24 hours for hours that continue past midnight.
+type Hours struct {
+	Zone       *Zone
+	Start, End time.Duration
+}
+
+// Overlap gets the intervals on the day of date when it's within working hours
+// in all zones, for example to schedule a meeting.
+//
+// The day is the calendar date of date in its location, from midnight up to the
+// next midnight. The working hours are the wall clock time since local midnight
+// in every zone; Overlap(date, zones, 9*time.Hour, 17*time.Hour) is from 09:00 to
+// 17:00 in every zone, on every DST day. The working hours of the previous and
+// next local day are used if they fall on the day of date.
+//
+// The returned intervals are sorted and in UTC.
+func Overlap(date time.Time, zones []*Zone, workStart, workEnd time.Duration) []Interval {
+	hours := make([]Hours, 0, len(zones))
+	for _, z := range zones {
+		hours = append(hours, Hours{Zone: z, Start: workStart, End: workEnd})
+	}
+	return OverlapHours(date, hours)
+}
+
+// OverlapHours is like Overlap(), but with different working hours for every
+// zone.
+func OverlapHours(date time.Time, hours []Hours) []Interval {
+	var (
+		y, m, d = date.Date()
+		r       = []Interval{{
+			Start: wallDate(y, m, d, 0, date.Location()).UTC(),
+			End:   wallDate(y, m, d+1, 0, date.Location()).UTC(),
+		}}
+	)
+	for _, h := range hours {
+		r = intersect(r, h.intervals(r[0].Start, r[len(r)-1].End))
+		if len(r) == 0 {
+			return nil
+		}
+	}
+	return r
+}
+
+// intervals gets the working hours between from and to.
+func (h Hours) intervals(from, to time.Time) []Interval {
+	var (
+		loc     = h.Zone.Loc()
+		y, m, d = from.In(loc).Date()
+		r       []Interval
+	)
+	// One day before and after is enough for any difference in offset; one more
+	// for hours that continue past midnight.
+	for i := -2; i <= 1; i++ {
+		iv := Interval{
+			Start: wallDate(y, m, d+i, h.Start, loc).UTC(),
+			End:   wallDate(y, m, d+i, h.End, loc).UTC(),
+		}
+		if iv.Start.Before(from) {
+			iv.Start = from
+		}
+		if iv.End.After(to) {
+			iv.End = to
+		}
+		if iv.Start.Before(iv.End) {
+			r = append(r, iv)
+		}
+	}
+	// The days are in order, but intervals may still overlap for hours that
+	// are longer than a day.
+	return merge(r)
+}
+
+// merge overlapping and adjacent intervals in the sorted list.
+func merge(list []Interval) []Interval {
+	r := list[:0]
+	for _, iv := range list {
+		if len(r) > 0 && !iv.Start.After(r[len(r)-1].End) {
+			if iv.End.After(r[len(r)-1].End) {
+				r[len(r)-1].End = iv.End
+			}
+			continue
+		}
+		r = append(r, iv)
+	}
+	return r
+}
+
+// intersect gets the intersection of two sorted lists of intervals.
+func intersect(a, b []Interval) []Interval {
+	var r []Interval
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].Start, a[i].End
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+		if start.Before(end) {
+			r = append(r, Interval{Start: start, End: end})
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return r
+}
@@ -0,0 +1,124 @@
+package tz
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOverlap(t *testing.T) {
+	var (
+		ams  = MustNew("NL", "Europe/Brussels")
+		mak  = MustNew("", "Asia/Makassar")
+		sao  = MustNew("", "America/Sao_Paulo")
+		lon  = MustNew("", "Europe/London")
+		tok  = MustNew("", "Asia/Tokyo")
+		date = func(s string, z *Zone) time.Time {
+			t, err := time.ParseInLocation("2006-01-02", s, z.Loc())
+			if err != nil {
+				panic(err)
+			}
+			return t
+		}
+		h = time.Hour
+	)
+
+	tests := []struct {
+		date  time.Time
+		hours []Hours
+		want  string
+	}{
+		{date("2024-07-01", UTC), []Hours{{ams, 9 * h, 17 * h}, {mak, 9 * h, 17 * h}},
+			"07:00–09:00"},
+		{date("2024-07-01", UTC), []Hours{{ams, 9 * h, 17 * h}, {mak, 9 * h, 17 * h}, {sao, 9 * h, 17 * h}},
+			""},
+		{date("2024-07-01", UTC), []Hours{{ams, 9 * h, 17 * h}, {mak, 7 * h, 17 * h}, {sao, 6 * h, 17 * h}},
+			""},
+		{date("2024-07-01", UTC), []Hours{{ams, 8 * h, 17 * h}, {mak, 9 * h, 18 * h}, {sao, 5 * h, 17 * h}},
+			"08:00–10:00"},
+		{date("2024-01-15", UTC), []Hours{{ams, 9 * h, 17 * h}, {sao, 9 * h, 17 * h}},
+			"12:00–16:00"},
+
+		// DST change; Europe/London changes at 01:00 UTC, and Europe/Brussels
+		// has 23 hours.
+		{date("2024-03-31", ams), []Hours{{ams, 9 * h, 17 * h}, {lon, 9 * h, 17 * h}},
+			"08:00–15:00"},
+		{date("2024-03-31", ams), []Hours{{ams, 0, 24 * h}},
+			"2024-03-30 23:00–22:00"},
+		{date("2024-10-27", ams), []Hours{{ams, 0, 24 * h}},
+			"2024-10-26 22:00–23:00"},
+		// 02:30 doesn't exist.
+		{date("2024-03-31", ams), []Hours{{ams, 2*h + 30*time.Minute, 4 * h}},
+			"01:00–02:00"},
+		// Midnight doesn't exist.
+		{time.Date(2018, 11, 4, 12, 0, 0, 0, sao.Loc()), []Hours{{sao, 0, 24 * h}},
+			"03:00–2018-11-05 02:00"},
+
+		// Previous and next local day.
+		{date("2024-07-01", UTC), []Hours{{tok, 0, 10 * h}},
+			"00:00–01:00 15:00–2024-07-02 00:00"},
+		{date("2024-07-01", UTC), []Hours{{tok, 20 * h, 34 * h}},
+			"00:00–01:00 11:00–2024-07-02 00:00"},
+		{date("2024-07-01", UTC), []Hours{{tok, 0, 10 * h}, {tok, 20 * h, 34 * h}},
+			"00:00–01:00 15:00–2024-07-02 00:00"},
+
+		{date("2024-07-01", UTC), []Hours{{ams, 17 * h, 9 * h}}, ""},
+		{date("2024-07-01", UTC), nil, "00:00–2024-07-02 00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			have := OverlapHours(tt.date, tt.hours)
+			if h := fmtIntervals(tt.date, have); h != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", h, tt.want)
+			}
+			for _, iv := range have {
+				if iv.Start.Location() != time.UTC || iv.End.Location() != time.UTC {
+					t.Errorf("not in UTC: %v", iv)
+				}
+			}
+		})
+	}
+}
+
+func TestOverlapDuration(t *testing.T) {
+	var (
+		ams = MustNew("NL", "Europe/Brussels")
+		lon = MustNew("", "Europe/London")
+	)
+	for _, tt := range []struct {
+		date string
+		want time.Duration
+	}{
+		{"2024-03-30", 8 * time.Hour},
+		{"2024-03-31", 8 * time.Hour},
+		{"2024-10-27", 8 * time.Hour},
+	} {
+		d, _ := time.ParseInLocation("2006-01-02", tt.date, ams.Loc())
+		have := Overlap(d, []*Zone{ams}, 9*time.Hour, 17*time.Hour)
+		if len(have) != 1 || have[0].Duration() != tt.want {
+			t.Errorf("%s: %v", tt.date, have)
+		}
+
+		// Same wall clock hours in both zones.
+		have = Overlap(d, []*Zone{ams, lon}, 9*time.Hour, 17*time.Hour)
+		if len(have) != 1 || have[0].Duration() != 7*time.Hour {
+			t.Errorf("%s: %v", tt.date, have)
+		}
+	}
+}
+
+// Format intervals as UTC; the date is omitted if it's the same as the calendar
+// date of date.
+func fmtIntervals(date time.Time, list []Interval) string {
+	day := date.Format("2006-01-02 ")
+	f := func(t time.Time) string {
+		return strings.TrimPrefix(t.Format("2006-01-02 15:04"), day)
+	}
+	s := make([]string, 0, len(list))
+	for _, iv := range list {
+		s = append(s, fmt.Sprintf("%s–%s", f(iv.Start), f(iv.End)))
+	}
+	return strings.Join(s, " ")
+}
@@ -0,0 +1,95 @@
+package tz
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseInZone parses a time with a trailing zone, such as:
+//
+//	2024-03-01 10:00 Europe/Amsterdam    IANA name
+//	2024-03-01 10:00 NL.Europe/Brussels  Zone.String()
+//	2024-03-01 10:00 Asia/Saigon         Alias
+//	2024-03-01 10:00 CET                 Abbreviation
+//
+// The layout is for the time without the zone, and the zone is separated by
+// whitespace. The time is parsed in fallback if there is no zone, or UTC if
+// fallback is nil. The zone that was used is returned.
+//
+// For abbreviations the offset of that abbreviation is used, even if something
+// else is valid at that time. For example "2024-07-01 10:00 CET" is 10:00 UTC+1,
+// even though Europe/Brussels uses CEST (UTC+2) in July. Ambiguous abbreviations
+// are resolved with ByAbbr(), using the fallback's country as a hint.
+//
+// Unknown abbreviations are an error, rather than creating a fabricated location
+// with a zero offset like time.Parse() does (as long as the layout doesn't
+// contain a zone). Unknown zones such as "Asia/Denpasar" or "XX.Nowhere/City"
+// return the *LookupError from New().
+func ParseInZone(layout, value string, fallback *Zone) (time.Time, *Zone, error) {
+	if fallback == nil {
+		fallback = UTC
+	}
+
+	value = strings.TrimSpace(value)
+	i := strings.LastIndexAny(value, " \t")
+	if i == -1 {
+		t, err := time.ParseInLocation(layout, value, fallback.Loc())
+		return t, fallback, err
+	}
+	head, tail := strings.TrimSpace(value[:i]), value[i+1:]
+
+	var (
+		z   *Zone
+		err error
+	)
+	switch cc, zone, ok := strings.Cut(tail, "."); {
+	case ok && (strings.Contains(zone, "/") || zone == "UTC"): // Zone.String()
+		z, err = New(cc, zone)
+	case strings.Contains(tail, "/") || tail == "UTC": // IANA name or alias.
+		z, err = New("", tail)
+	default:
+		if zones := ByAbbr(tail, fallback.CountryCode); len(zones) > 0 {
+			return parseAbbr(layout, head, tail, zones[0])
+		}
+		if _, ok := aliases[tail]; ok { // Aliases without a /, like "Japan".
+			z, err = New("", tail)
+		}
+	}
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if z != nil {
+		t, err := time.ParseInLocation(layout, head, z.Loc())
+		return t, z, err
+	}
+
+	t, err := time.ParseInLocation(layout, value, fallback.Loc())
+	return t, fallback, err
+}
+
+func parseAbbr(layout, value, abbr string, z *Zone) (time.Time, *Zone, error) {
+	wall, err := time.ParseInLocation(layout, value, time.UTC)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	off, ok := z.abbrOffset(abbr, wall)
+	if !ok { // Shouldn't happen, but just in case.
+		t, err := time.ParseInLocation(layout, value, z.Loc())
+		return t, z, err
+	}
+	return wall.Add(-off).In(z.Loc()), z, nil
+}
+
+// abbrOffset gets the offset for the abbreviation around the time tt.
+func (t *Zone) abbrOffset(abbr string, tt time.Time) (time.Duration, bool) {
+	if name, off := tt.In(t.Loc()).Zone(); strings.EqualFold(name, abbr) {
+		return time.Duration(off) * time.Second, true
+	}
+	if tr, ok := t.PrevTransition(tt); ok && strings.EqualFold(tr.AbbrBefore, abbr) {
+		return tr.OffsetBefore, true
+	}
+	if tr, ok := t.NextTransition(tt); ok && strings.EqualFold(tr.AbbrAfter, abbr) {
+		return tr.OffsetAfter, true
+	}
+	return 0, false
+}
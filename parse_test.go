@@ -0,0 +1,86 @@
+package tz
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseInZone(t *testing.T) {
+	const layout = "2006-01-02 15:04"
+	tests := []struct {
+		in       string
+		fallback *Zone
+		want     string
+		wantZone string
+		wantErr  string
+	}{
+		{"2024-03-01 10:00 Europe/Brussels", nil, "2024-03-01 10:00:00 +0100 CET", "BE.Europe/Brussels", ""},
+		{"2024-07-01 10:00 Europe/Brussels", nil, "2024-07-01 10:00:00 +0200 CEST", "BE.Europe/Brussels", ""},
+		{"2024-03-01 10:00 NL.Europe/Brussels", nil, "2024-03-01 10:00:00 +0100 CET", "NL.Europe/Brussels", ""},
+		{"2024-03-01 10:00 Europe/Amsterdam", nil, "2024-03-01 10:00:00 +0100 CET", "BE.Europe/Brussels", ""},
+		{"2024-03-01 10:00 Asia/Saigon", nil, "2024-03-01 10:00:00 +0700 +07", "VN.Asia/Ho_Chi_Minh", ""},
+		{"2024-03-01 10:00 Japan", nil, "2024-03-01 10:00:00 +0900 JST", "AU.Asia/Tokyo", ""},
+		{"2024-03-01 10:00 UTC", MustNew("ID", "Asia/Makassar"), "2024-03-01 10:00:00 +0000 UTC", ".UTC", ""},
+		{"2024-03-01 10:00  .UTC ", nil, "2024-03-01 10:00:00 +0000 UTC", ".UTC", ""},
+
+		// Abbreviations
+		{"2024-03-01 10:00 WITA", nil, "2024-03-01 10:00:00 +0800 WITA", "ID.Asia/Makassar", ""},
+		{"2024-03-01 10:00 cet", nil, "2024-03-01 10:00:00 +0100 CET", "BE.Europe/Brussels", ""},
+		{"2024-07-01 10:00 CEST", nil, "2024-07-01 10:00:00 +0200 CEST", "BE.Europe/Brussels", ""},
+		{"2024-07-01 10:00 CET", nil, "2024-07-01 11:00:00 +0200 CEST", "BE.Europe/Brussels", ""},
+		{"2024-03-01 10:00 CEST", nil, "2024-03-01 09:00:00 +0100 CET", "BE.Europe/Brussels", ""},
+		{"2024-03-01 10:00 IST", nil, "2024-03-01 10:00:00 +0530 IST", "IN.Asia/Kolkata", ""},
+		{"2024-07-01 10:00 IST", MustNew("IE", ""), "2024-07-01 10:00:00 +0100 IST", "IE.Europe/Dublin", ""},
+
+		// Fallback
+		{"2024-03-01 10:00", nil, "2024-03-01 10:00:00 +0000 UTC", ".UTC", ""},
+		{"2024-03-01 10:00", MustNew("ID", "Asia/Makassar"), "2024-03-01 10:00:00 +0800 WITA", "ID.Asia/Makassar", ""},
+
+		// Errors
+		{"2024-03-01 10:00 XYZ", nil, "", "", "extra text"},
+		{"2024-03-01 10:00 Asia/Denpasar", nil, "", "", `unknown timezone: "" "Asia/Denpasar"`},
+		{"2024-03-01 10:00 XX.Nowhere/City", nil, "", "", `unknown timezone: "XX" "Nowhere/City"`},
+		{"2024-03-01 Europe/Brussels", nil, "", "", "cannot parse"},
+		{"2024-03-01 CET", nil, "", "", "cannot parse"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			have, z, err := ParseInZone(layout, tt.in, tt.fallback)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("\nout:  %#v\nwant: %#v\n", err, tt.wantErr)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+			if s := have.Format("2006-01-02 15:04:05 -0700 MST"); s != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", s, tt.want)
+			}
+			if z.String() != tt.wantZone {
+				t.Errorf("\nhave: %s\nwant: %s", z, tt.wantZone)
+			}
+			if z != UTC && have.Location() != z.Location {
+				t.Errorf("wrong location: %s", have.Location())
+			}
+		})
+	}
+
+	t.Run("lookup error", func(t *testing.T) {
+		_, _, err := ParseInZone(layout, "2024-03-01 10:00 Asia/Denpasar", nil)
+		var lerr *LookupError
+		if !errors.Is(err, ErrUnknownZone) || !errors.As(err, &lerr) || lerr.Zone != "Asia/Denpasar" {
+			t.Errorf("wrong error: %#v", err)
+		}
+	})
+
+	t.Run("layout", func(t *testing.T) {
+		have, _, err := ParseInZone("Jan _2 2006 "+time.Kitchen, "Mar  1 2024 3:04PM Asia/Makassar", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := have.Format("2006-01-02 15:04 MST"); s != "2024-03-01 15:04 WITA" {
+			t.Error(s)
+		}
+	})
+}
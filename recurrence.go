@@ -0,0 +1,381 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is how often a Recurrence repeats.
+type Frequency uint8
+
+// Frequencies for Recurrence.
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = []string{"", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (f Frequency) String() string {
+	if int(f) < len(frequencies) && f > 0 {
+		return frequencies[f]
+	}
+	return fmt.Sprintf("Frequency(%d)", f)
+}
+
+// RecurDay is a weekday in BYDAY, with an optional ordinal for monthly and
+// yearly recurrences: {1, time.Monday} is the first Monday of the month, {-1,
+// time.Friday} the last Friday, and {0, time.Friday} every Friday.
+type RecurDay struct {
+	N       int
+	Weekday time.Weekday
+}
+
+var weekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+func (d RecurDay) String() string {
+	if d.N == 0 {
+		return weekdays[d.Weekday]
+	}
+	return strconv.Itoa(d.N) + weekdays[d.Weekday]
+}
+
+// Recurrence is a recurring event in a zone, such as "every Monday at 09:00 in
+// Europe/Amsterdam", using a subset of RFC 5545 recurrence rules (RRULE).
+//
+// The recurrence is in wall clock time: every Monday at 09:00 stays at 09:00
+// across DST changes. Times that are ambiguous or don't exist on the day of an
+// occurrence are resolved with Policy; the Reject policy skips them, but they
+// still count towards Count.
+type Recurrence struct {
+	Zone  *Zone
+	Start time.Time // First occurrence (DTSTART); the time of day is used for all occurrences.
+
+	Freq       Frequency
+	Interval   int          // Every n days, weeks, months, or years; 0 is the same as 1.
+	ByDay      []RecurDay   // Only on these weekdays.
+	ByMonthDay []int        // Only on these days of the month; -1 is the last day.
+	ByMonth    []time.Month // Only in these months.
+	Count      int          // Stop after this many occurrences; 0 is no limit.
+	Until      time.Time    // Stop after this time (inclusive); zero is no limit.
+
+	Policy Policy // How to resolve ambiguous and nonexistent times; the default is ShiftForward.
+}
+
+// ErrRecurrence is used if a recurrence rule can't be parsed.
+var ErrRecurrence = errors.New("invalid recurrence rule")
+
+// ParseRecurrence parses a RFC 5545 recurrence rule, such as
+// "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", with an optional "RRULE:" prefix.
+//
+// Only FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY, BYMONTHDAY,
+// BYMONTH, COUNT, and UNTIL are supported; other parts are an error. An UNTIL without
+// "Z" is in the zone, and an UNTIL date without time includes that day.
+//
+// The Policy is ShiftForward, which is the same as RFC 5545 for ambiguous times,
+// and uses the first valid time after a gap rather than shifting by the length
+// of the gap.
+func ParseRecurrence(zone *Zone, start time.Time, rule string) (*Recurrence, error) {
+	r := &Recurrence{Zone: zone, Start: start}
+
+	rule = strings.TrimSpace(rule)
+	if len(rule) > 6 && strings.EqualFold(rule[:6], "RRULE:") {
+		rule = rule[6:]
+	}
+	for _, part := range strings.Split(rule, ";") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q: no = in %q", ErrRecurrence, rule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			i := slices.Index(frequencies, strings.ToUpper(v))
+			if i < 1 {
+				err = errors.New("unsupported frequency")
+			}
+			r.Freq = Frequency(i)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(v)
+			if err == nil && r.Interval < 1 {
+				err = errors.New("must be 1 or more")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(v)
+			if err == nil && r.Count < 1 {
+				err = errors.New("must be 1 or more")
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(v, zone)
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				var rd RecurDay
+				rd, err = parseRecurDay(d)
+				if err != nil {
+					break
+				}
+				r.ByDay = append(r.ByDay, rd)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(v, ",") {
+				var n int
+				n, err = strconv.Atoi(d)
+				if err == nil && (n == 0 || n < -31 || n > 31) {
+					err = errors.New("must be 1 to 31 or -1 to -31")
+				}
+				if err != nil {
+					break
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, d := range strings.Split(v, ",") {
+				var n int
+				n, err = strconv.Atoi(d)
+				if err == nil && (n < 1 || n > 12) {
+					err = errors.New("must be 1 to 12")
+				}
+				if err != nil {
+					break
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(n))
+			}
+		default:
+			err = errors.New("not supported")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s: %s", ErrRecurrence, rule, part, err)
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %q: %s", ErrRecurrence, rule, err)
+	}
+	return r, nil
+}
+
+func parseUntil(v string, zone *Zone) (time.Time, error) {
+	switch {
+	case strings.HasSuffix(v, "Z"):
+		return time.Parse("20060102T150405Z", v)
+	case strings.Contains(v, "T"):
+		return time.ParseInLocation("20060102T150405", v, zone.Loc())
+	}
+	d, err := time.ParseInLocation("20060102", v, zone.Loc())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return zone.EndOfDay(d), nil
+}
+
+func parseRecurDay(s string) (RecurDay, error) {
+	if len(s) < 2 {
+		return RecurDay{}, fmt.Errorf("invalid day %q", s)
+	}
+	i := slices.Index(weekdays, strings.ToUpper(s[len(s)-2:]))
+	if i == -1 {
+		return RecurDay{}, fmt.Errorf("invalid day %q", s)
+	}
+	d := RecurDay{Weekday: time.Weekday(i)}
+	if n := s[:len(s)-2]; n != "" {
+		var err error
+		d.N, err = strconv.Atoi(n)
+		if err != nil || d.N == 0 || d.N < -53 || d.N > 53 {
+			return RecurDay{}, fmt.Errorf("invalid day %q", s)
+		}
+	}
+	return d, nil
+}
+
+func (r Recurrence) validate() error {
+	switch {
+	case r.Freq < Daily || r.Freq > Yearly:
+		return errors.New("FREQ is required")
+	case r.Count > 0 && !r.Until.IsZero():
+		return errors.New("can't have both COUNT and UNTIL")
+	case r.Freq == Weekly && len(r.ByMonthDay) > 0:
+		return errors.New("can't have BYMONTHDAY with FREQ=WEEKLY")
+	}
+	if r.Freq == Daily || r.Freq == Weekly {
+		for _, d := range r.ByDay {
+			if d.N != 0 {
+				return fmt.Errorf("can't have BYDAY=%s with FREQ=%s", d, r.Freq)
+			}
+		}
+	}
+	return nil
+}
+
+// String gets the RFC 5545 recurrence rule, without the "RRULE:" prefix.
+func (r Recurrence) String() string {
+	b := new(strings.Builder)
+	b.WriteString("FREQ=" + r.Freq.String())
+	if r.Interval > 1 {
+		b.WriteString(";INTERVAL=" + strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		b.WriteString(";BYDAY=")
+		for i, d := range r.ByDay {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(d.String())
+		}
+	}
+	if len(r.ByMonthDay) > 0 {
+		b.WriteString(";BYMONTHDAY=")
+		for i, d := range r.ByMonthDay {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Itoa(d))
+		}
+	}
+	if len(r.ByMonth) > 0 {
+		b.WriteString(";BYMONTH=")
+		for i, m := range r.ByMonth {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Itoa(int(m)))
+		}
+	}
+	if r.Count > 0 {
+		b.WriteString(";COUNT=" + strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		b.WriteString(";UNTIL=" + r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return b.String()
+}
+
+// Between gets all occurrences from (inclusive) up to to (exclusive), in the
+// zone.
+//
+// Occurrences are calculated from the start, so COUNT includes occurrences
+// before from and occurrences skipped by the Reject policy. The start is only an
+// occurrence if it matches the rule.
+func (r Recurrence) Between(from, to time.Time) []time.Time {
+	var (
+		loc        = r.Zone.Loc()
+		start      = r.Start.In(loc)
+		since      = sinceMidnight(start)
+		sy, sm, sd = start.Date()
+		interval   = max(r.Interval, 1)
+		n          int
+		res        []time.Time
+	)
+	if r.Freq < Daily || r.Freq > Yearly {
+		return nil
+	}
+
+	for k := 0; ; k += interval {
+		first, last := r.period(sy, sm, sd, k)
+		if y, m, d := first.Date(); !wallDate(y, m, d, 0, loc).Before(to) {
+			return res
+		}
+
+		for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+			if !r.match(day, start) {
+				continue
+			}
+
+			y, m, d := day.Date()
+			earlier, later, s := wallTimes(y, m, d, since, loc)
+			t, ok := pick(earlier, later, s, r.Policy)
+			if earlier.Before(start) && later.Before(start) {
+				continue
+			}
+			if !r.Until.IsZero() && earlier.After(r.Until) {
+				return res
+			}
+			n++
+			if ok && !t.Before(from) && t.Before(to) {
+				res = append(res, t)
+			}
+			if r.Count > 0 && n >= r.Count {
+				return res
+			}
+		}
+	}
+}
+
+// period gets the first and last day of the kth period after the start date, as
+// dates in UTC.
+func (r Recurrence) period(y int, m time.Month, d, k int) (time.Time, time.Time) {
+	switch r.Freq {
+	case Daily:
+		t := time.Date(y, m, d+k, 0, 0, 0, 0, time.UTC)
+		return t, t
+	case Weekly: // Weeks start on Monday, which is the RFC 5545 default.
+		t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		t = t.AddDate(0, 0, 7*k-(int(t.Weekday())+6)%7)
+		return t, t.AddDate(0, 0, 6)
+	case Monthly:
+		t := time.Date(y, m+time.Month(k), 1, 0, 0, 0, 0, time.UTC)
+		return t, t.AddDate(0, 1, -1)
+	default:
+		t := time.Date(y+k, 1, 1, 0, 0, 0, 0, time.UTC)
+		return t, t.AddDate(1, 0, -1)
+	}
+}
+
+// match reports if the day matches the rule.
+func (r Recurrence) match(day, start time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, day.Month()) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 {
+		last := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if !slices.ContainsFunc(r.ByMonthDay, func(n int) bool {
+			return n == day.Day() || n < 0 && last+n+1 == day.Day()
+		}) {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 {
+		return slices.ContainsFunc(r.ByDay, func(d RecurDay) bool { return r.matchDay(d, day) })
+	}
+	if len(r.ByMonthDay) > 0 {
+		return true
+	}
+
+	// Without BYDAY or BYMONTHDAY the day of the start is used.
+	switch r.Freq {
+	case Weekly:
+		return day.Weekday() == start.Weekday()
+	case Monthly:
+		return day.Day() == start.Day()
+	case Yearly:
+		return day.Day() == start.Day() && (len(r.ByMonth) > 0 || day.Month() == start.Month())
+	}
+	return true
+}
+
+// matchDay reports if the day matches the BYDAY day. The ordinal is in the
+// month for monthly recurrences and yearly recurrences with BYMONTH, and in the
+// year for other yearly recurrences.
+func (r Recurrence) matchDay(d RecurDay, day time.Time) bool {
+	if day.Weekday() != d.Weekday {
+		return false
+	}
+	if d.N == 0 {
+		return true
+	}
+
+	n, total := day.Day(), time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if r.Freq == Yearly && len(r.ByMonth) == 0 {
+		n, total = day.YearDay(), time.Date(day.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+	if d.N > 0 {
+		return (n-1)/7+1 == d.N
+	}
+	return -((total-n)/7 + 1) == d.N
+}
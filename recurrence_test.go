@@ -0,0 +1,195 @@
+package tz
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecurrence(t *testing.T) {
+	var (
+		bru = MustNew("", "Europe/Brussels")
+		at  = func(s string) time.Time {
+			t, err := time.ParseInLocation("2006-01-02 15:04", s, bru.Loc())
+			if err != nil {
+				panic(err)
+			}
+			return t
+		}
+	)
+
+	tests := []struct {
+		start    string
+		rule     string
+		from, to string
+		want     string
+	}{
+		// Stays at 09:00 across DST.
+		{"2024-03-18 09:00", "FREQ=WEEKLY", "2024-03-01 00:00", "2024-04-09 00:00",
+			"2024-03-18 09:00 +0100, 2024-03-25 09:00 +0100, 2024-04-01 09:00 +0200, 2024-04-08 09:00 +0200"},
+		{"2024-03-18 09:00", "RRULE:FREQ=WEEKLY;BYDAY=MO", "2024-03-25 09:00", "2024-04-08 09:00",
+			"2024-03-25 09:00 +0100, 2024-04-01 09:00 +0200"},
+		{"2024-10-20 09:00", "FREQ=DAILY;COUNT=3;INTERVAL=7", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-10-20 09:00 +0200, 2024-10-27 09:00 +0100, 2024-11-03 09:00 +0100"},
+
+		// COUNT includes occurrences before from.
+		{"2024-01-01 10:00", "FREQ=DAILY;COUNT=5", "2024-01-04 00:00", "2025-01-01 00:00",
+			"2024-01-04 10:00 +0100, 2024-01-05 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=DAILY;INTERVAL=2;UNTIL=20240107T090000Z", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-01 10:00 +0100, 2024-01-03 10:00 +0100, 2024-01-05 10:00 +0100, 2024-01-07 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=DAILY;UNTIL=20240103", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-01 10:00 +0100, 2024-01-02 10:00 +0100, 2024-01-03 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=DAILY;UNTIL=20240103T095959", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-01 10:00 +0100, 2024-01-02 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=DAILY;BYDAY=SA,SU;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-06 10:00 +0100, 2024-01-07 10:00 +0100, 2024-01-13 10:00 +0100"},
+
+		// Weeks start on Monday.
+		{"2024-01-03 10:00", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR;COUNT=5", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-03 10:00 +0100, 2024-01-05 10:00 +0100, 2024-01-15 10:00 +0100, 2024-01-17 10:00 +0100, 2024-01-19 10:00 +0100"},
+		{"2024-01-07 10:00", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SU;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-07 10:00 +0100, 2024-01-15 10:00 +0100, 2024-01-21 10:00 +0100"},
+
+		{"2024-01-31 10:00", "FREQ=MONTHLY;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-31 10:00 +0100, 2024-03-31 10:00 +0200, 2024-05-31 10:00 +0200"},
+		{"2024-01-01 10:00", "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-31 10:00 +0100, 2024-02-29 10:00 +0100, 2024-03-31 10:00 +0200"},
+		{"2024-01-01 10:00", "FREQ=MONTHLY;BYMONTHDAY=1,15;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-01 10:00 +0100, 2024-01-15 10:00 +0100, 2024-02-01 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-26 10:00 +0100, 2024-02-23 10:00 +0100, 2024-03-29 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=MONTHLY;BYDAY=2TU,4TU;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-01-09 10:00 +0100, 2024-01-23 10:00 +0100, 2024-02-13 10:00 +0100"},
+		// Friday the 13th.
+		{"2024-01-01 10:00", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-09-13 10:00 +0200, 2024-12-13 10:00 +0100, 2025-06-13 10:00 +0200"},
+
+		{"2024-02-29 10:00", "FREQ=YEARLY;COUNT=2", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-02-29 10:00 +0100, 2028-02-29 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=YEARLY;BYDAY=20MO;COUNT=2", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-05-13 10:00 +0200, 2025-05-19 10:00 +0200"},
+		{"2024-01-01 10:00", "FREQ=YEARLY;BYDAY=-1SU;COUNT=1", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-12-29 10:00 +0100"},
+		{"2024-01-01 10:00", "FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU;COUNT=2", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-03-31 10:00 +0200, 2025-03-30 10:00 +0200"},
+		{"2024-01-01 10:00", "FREQ=YEARLY;BYMONTH=3;BYDAY=2SU;COUNT=2", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-03-10 10:00 +0100, 2025-03-09 10:00 +0100"},
+		{"2024-01-15 10:00", "FREQ=YEARLY;BYMONTH=1,7;COUNT=3", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-01-15 10:00 +0100, 2024-07-15 10:00 +0200, 2025-01-15 10:00 +0100"},
+		{"2024-01-15 10:00", "FREQ=MONTHLY;BYMONTH=2,4;COUNT=3", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-02-15 10:00 +0100, 2024-04-15 10:00 +0200, 2025-02-15 10:00 +0100"},
+		{"2024-10-01 10:00", "FREQ=YEARLY;BYMONTHDAY=1;COUNT=4", "2024-01-01 00:00", "2030-01-01 00:00",
+			"2024-10-01 10:00 +0200, 2024-11-01 10:00 +0100, 2024-12-01 10:00 +0100, 2025-01-01 10:00 +0100"},
+
+		// Gap and ambiguous times with the default ShiftForward policy.
+		{"2024-03-30 02:30", "FREQ=DAILY;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-03-30 02:30 +0100, 2024-03-31 03:00 +0200, 2024-04-01 02:30 +0200"},
+		{"2024-10-26 02:30", "FREQ=DAILY;COUNT=3", "2024-01-01 00:00", "2025-01-01 00:00",
+			"2024-10-26 02:30 +0200, 2024-10-27 02:30 +0200, 2024-10-28 02:30 +0100"},
+
+		{"2024-01-01 10:00", "FREQ=DAILY", "2023-01-01 00:00", "2024-01-01 10:00", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := ParseRecurrence(bru, at(tt.start), tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			have := fmtTimes(r.Between(at(tt.from), at(tt.to)))
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrencePolicy(t *testing.T) {
+	bru := MustNew("", "Europe/Brussels")
+	start := time.Date(2024, 3, 30, 2, 30, 0, 0, bru.Loc())
+	from, to := start, start.AddDate(0, 0, 3)
+
+	tests := []struct {
+		policy Policy
+		want   string
+	}{
+		{Earlier, "2024-03-30 02:30 +0100, 2024-03-31 01:30 +0100, 2024-04-01 02:30 +0200"},
+		{Later, "2024-03-30 02:30 +0100, 2024-03-31 03:30 +0200, 2024-04-01 02:30 +0200"},
+		{ShiftForward, "2024-03-30 02:30 +0100, 2024-03-31 03:00 +0200, 2024-04-01 02:30 +0200"},
+		{Reject, "2024-03-30 02:30 +0100, 2024-04-01 02:30 +0200"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			r := Recurrence{Zone: bru, Start: start, Freq: Daily, Count: 3, Policy: tt.policy}
+			have := fmtTimes(r.Between(from, to))
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		r := Recurrence{Zone: bru, Start: start, Freq: Daily, Count: 3}
+		have := fmtTimes(r.Between(from, to))
+		if want := tests[2].want; have != want {
+			t.Errorf("\nhave: %s\nwant: %s", have, want)
+		}
+	})
+}
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		rule, want, wantErr string
+	}{
+		{"FREQ=WEEKLY", "FREQ=WEEKLY", ""},
+		{"rrule:freq=weekly;byday=mo,we", "FREQ=WEEKLY;BYDAY=MO,WE", ""},
+		{"FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;BYMONTHDAY=1,-1;COUNT=3", "FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;BYMONTHDAY=1,-1;COUNT=3", ""},
+		{"FREQ=YEARLY;BYMONTH=3,10;BYDAY=-1SU", "FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3,10", ""},
+		{"FREQ=DAILY;UNTIL=20240101T100000Z", "FREQ=DAILY;UNTIL=20240101T100000Z", ""},
+		{"FREQ=DAILY;UNTIL=20240101T100000", "FREQ=DAILY;UNTIL=20240101T090000Z", ""},
+		{"FREQ=DAILY;INTERVAL=1", "FREQ=DAILY", ""},
+
+		{"", "", "no = in"},
+		{"COUNT=1", "", "FREQ is required"},
+		{"FREQ=HOURLY", "", "unsupported frequency"},
+		{"FREQ=DAILY;BYSETPOS=1", "", "BYSETPOS=1: not supported"},
+		{"FREQ=DAILY;COUNT=0", "", "must be 1 or more"},
+		{"FREQ=DAILY;INTERVAL=x", "", "invalid syntax"},
+		{"FREQ=DAILY;COUNT=2;UNTIL=20240101", "", "both COUNT and UNTIL"},
+		{"FREQ=DAILY;BYDAY=1MO", "", "can't have BYDAY=1MO with FREQ=DAILY"},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", "", "can't have BYMONTHDAY with FREQ=WEEKLY"},
+		{"FREQ=MONTHLY;BYDAY=XX", "", `invalid day "XX"`},
+		{"FREQ=MONTHLY;BYDAY=0MO", "", `invalid day "0MO"`},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", "", "must be 1 to 31"},
+		{"FREQ=DAILY;UNTIL=2024", "", "cannot parse"},
+		{"FREQ=YEARLY;BYMONTH=13", "", "must be 1 to 12"},
+	}
+
+	bru := MustNew("", "Europe/Brussels")
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := ParseRecurrence(bru, time.Now(), tt.rule)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("wrong error\nhave: %v\nwant: %s", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrRecurrence) {
+					t.Errorf("not ErrRecurrence: %v", err)
+				}
+				return
+			}
+			if have := r.String(); have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func fmtTimes(times []time.Time) string {
+	s := make([]string, 0, len(times))
+	for _, t := range times {
+		s = append(s, t.Format("2006-01-02 15:04 -0700"))
+	}
+	return strings.Join(s, ", ")
+}
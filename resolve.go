@@ -0,0 +1,188 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Method is how Resolve() resolved a zone.
+type Method uint8
+
+// Methods for Resolve(); if more than one fallback applied then the last one in
+// this list is used. For example "NL", "Asia/Saigon" is CountryMismatch rather
+// than Alias.
+const (
+	Exact           Method = iota // Exact match; the country code may be blank.
+	Alias                         // Zone is an alias, such as Asia/Saigon for Asia/Ho_Chi_Minh.
+	EtcOffset                     // Etc/GMT+7 mapped to a zone in the country with that offset.
+	CountryDefault                // First zone for the country.
+	CountryMismatch               // Zone exists, but not in this country.
+	UTCFallback                   // Etc/UTC, Etc/Unknown, or an alias for them.
+	Windows                       // Windows timezone ID, such as "W. Europe Standard Time".
+)
+
+func (m Method) String() string {
+	switch m {
+	case Exact:
+		return "exact match"
+	case Alias:
+		return "alias"
+	case EtcOffset:
+		return "Etc/ offset"
+	case CountryDefault:
+		return "country default"
+	case CountryMismatch:
+		return "country mismatch"
+	case UTCFallback:
+		return "UTC fallback"
+	case Windows:
+		return "Windows ID"
+	}
+	return fmt.Sprintf("Method(%d)", m)
+}
+
+// Resolution describes how Resolve() resolved a zone.
+type Resolution struct {
+	Method      Method
+	CountryCode string // Country code and zone as given to Resolve().
+	Zone        string
+}
+
+// Resolve behaves like New(), but also returns how the zone was resolved.
+//
+// The Method is Exact if there's an error.
+func Resolve(ccode, zone string) (*Zone, Resolution, error) {
+	z, m, err := resolve(ccode, zone)
+	return z, Resolution{Method: m, CountryCode: ccode, Zone: zone}, err
+}
+
+// Aliases gets all zone names that are an alias for this zone, such as
+// Asia/Saigon for Asia/Ho_Chi_Minh, sorted by name.
+func (t *Zone) Aliases() []string {
+	if t == nil {
+		return nil
+	}
+	var (
+		zone = t.Zone
+		r    []string
+	)
+	if zone == "UTC" {
+		zone, r = "Etc/UTC", []string{"Etc/UTC"}
+	}
+	for k, v := range aliases {
+		if v == zone && k != t.Zone {
+			r = append(r, k)
+		}
+	}
+	sort.Strings(r)
+	return r
+}
+
+func resolve(ccode, zone string) (*Zone, Method, error) {
+	loadLocations()
+	input := zone
+
+	if zone == "UTC" {
+		return UTC, Exact, nil
+	}
+	if w, ok := windowsZone(zone, ccode); ok {
+		z, _, err := resolve(ccode, w)
+		if err != nil {
+			return nil, 0, err
+		}
+		return z, Windows, nil
+	}
+
+	m := Exact
+	if a, ok := aliases[zone]; ok {
+		zone, m = a, Alias
+	}
+	if strings.HasPrefix(zone, "Etc/") {
+		zone = zone[4:]
+		if zone == "UTC" || zone == "GMT" || zone == "Unknown" {
+			return UTC, UTCFallback, nil
+		}
+		if !strings.HasPrefix(zone, "GMT") {
+			return nil, 0, &LookupError{CountryCode: ccode, Zone: input, Err: ErrInvalidEtc}
+		}
+		o, err := strconv.ParseInt(zone[3:], 10, 8)
+		if err != nil {
+			return nil, 0, &LookupError{CountryCode: ccode, Zone: input, Err: ErrInvalidEtc}
+		}
+		off := int(o) * -60 // + and - are reversed in Etc/ listings
+
+		// If we have a country match the first one for this country that
+		// corresponds to the offset.
+		for _, z := range byCountry[ccode] {
+			if z.Offset() == off {
+				return z, EtcOffset, nil
+			}
+		}
+		// No matches for this offset, which shouldn't happen, but return the
+		// first for this country.
+		if ccode != "" {
+			if zones := byCountry[ccode]; len(zones) > 0 {
+				return zones[0], CountryDefault, nil
+			}
+		}
+
+		return nil, 0, &LookupError{CountryCode: ccode, Zone: input, Err: ErrUnknownZone}
+	}
+
+	// No zone name but country given; just get the first zone for that country,
+	// which is better than nothing.
+	if zone == "" && ccode != "" {
+		if zones := byCountry[ccode]; len(zones) > 0 {
+			return zones[0], CountryDefault, nil
+		}
+	}
+
+	if ccode != "" {
+		if z, ok := byCountryZone[countryZone{ccode, zone}]; ok {
+			return z, m, nil
+		}
+	}
+	if z, ok := byZone[zone]; ok {
+		if ccode != "" {
+			m = CountryMismatch
+		}
+		return z, m, nil
+	}
+	return nil, 0, &LookupError{CountryCode: ccode, Zone: input, Err: ErrUnknownZone}
+}
+
+// ErrNotExact is used by NewStrict() if New() would have used a fallback.
+var ErrNotExact = errors.New("not an exact match")
+
+// StrictError is returned by NewStrict() if the country code and zone don't
+// match exactly.
+type StrictError struct {
+	Resolution       // Fallback New() would have applied.
+	Match      *Zone // Zone New() would have returned.
+}
+
+func (e *StrictError) Error() string {
+	return fmt.Sprintf("%s: %q %q: %s; would resolve to %s", ErrNotExact, e.CountryCode, e.Zone, e.Method, e.Match)
+}
+
+func (e *StrictError) Unwrap() error { return ErrNotExact }
+
+// NewStrict behaves like New(), but returns a *StrictError if the country code
+// and zone name don't match exactly, instead of applying any fallback. For
+// example "NL", "Asia/Makassar" or "VN", "Asia/Saigon" are errors. The country
+// code may be blank.
+//
+// "UTC" is accepted, but aliases for it such as "Etc/UTC" are not.
+func NewStrict(ccode, zone string) (*Zone, error) {
+	z, r, err := Resolve(ccode, zone)
+	if err != nil {
+		return nil, err
+	}
+	if r.Method != Exact {
+		return nil, &StrictError{Resolution: r, Match: z}
+	}
+	return z, nil
+}
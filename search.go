@@ -0,0 +1,274 @@
+package tz
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Field is a field in a Zone that Search() matched.
+type Field string
+
+// Fields that Search() matches.
+const (
+	FieldZone        Field = "zone"         // Asia/Makassar
+	FieldCity        Field = "city"         // Makassar (from the zone name or an alias, with _ replaced by spaces)
+	FieldCountry     Field = "country"      // Indonesia
+	FieldCountryCode Field = "country_code" // ID
+	FieldComments    Field = "comments"     // Borneo (east, south), ...
+	FieldAbbr        Field = "abbr"         // WITA
+)
+
+// How much a match on every field is worth.
+var fieldWeight = map[Field]float64{
+	FieldCity:        1,
+	FieldZone:        0.95,
+	FieldCountry:     0.9,
+	FieldAbbr:        0.9,
+	FieldCountryCode: 0.85,
+	FieldComments:    0.7,
+}
+
+// Match is a result from Search().
+type Match struct {
+	Zone  *Zone
+	Score float64 // Between 0 and 1; higher is better.
+	Field Field   // Field that matched.
+	Text  string  // Text of the field that matched.
+	Start int     // Start and end of the match in Text as byte offsets, for
+	End   int     // highlighting.
+}
+
+// Search zones, for example for an autocomplete. The zone name, city name (also
+// from aliases, so "Amsterdam" finds NL.Europe/Brussels), country name, country
+// code, comments, and abbreviations are searched, and the best match on any of
+// those is used for every zone. Leading and trailing whitespace in the query is
+// ignored.
+//
+// Matches are case and diacritic insensitive ("tucuman" matches "Tucumán"), and
+// allow for some typos ("Amsterdma" matches "Amsterdam"). Exact matches score
+// higher than prefixes, which score higher than substrings and typos.
+//
+// The results are sorted by score, and at most limit are returned (or all if
+// limit is 0 or lower). A zone can be returned more than once for different
+// countries.
+func Search(query string, limit int) []Match {
+	q := []rune(normalize(strings.TrimSpace(query)))
+	if len(q) == 0 {
+		return nil
+	}
+	loadLocations()
+
+	var r []Match
+	for _, z := range Zones {
+		best := Match{Zone: z}
+		try := func(f Field, text string) {
+			score, start, end := matchText(q, text, f)
+			if score *= fieldWeight[f]; score > best.Score {
+				best.Score, best.Field, best.Text, best.Start, best.End = score, f, text, start, end
+			}
+		}
+
+		try(FieldCity, z.city())
+		for _, a := range byAlias[z] {
+			try(FieldCity, city(a))
+		}
+		try(FieldZone, z.Zone)
+		try(FieldCountry, z.CountryName)
+		try(FieldCountryCode, z.CountryCode)
+		for _, a := range z.Abbr {
+			try(FieldAbbr, a)
+		}
+		try(FieldComments, z.Comments)
+		if best.Score > 0 {
+			r = append(r, best)
+		}
+	}
+
+	slices.SortStableFunc(r, func(a, b Match) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+	if limit > 0 && len(r) > limit {
+		r = r[:limit]
+	}
+	return r
+}
+
+// city gets the city from the zone name: "America/Argentina/Buenos_Aires" →
+// "Buenos Aires".
+func (t *Zone) city() string { return city(t.Zone) }
+
+func city(zone string) string {
+	if i := strings.LastIndexByte(zone, '/'); i > -1 {
+		zone = zone[i+1:]
+	}
+	return strings.ReplaceAll(zone, "_", " ")
+}
+
+// matchText matches the normalized query q against text, returning the score
+// and start and end of the match as byte offsets in text.
+func matchText(q []rune, text string, f Field) (float64, int, int) {
+	if text == "" {
+		return 0, 0, 0
+	}
+	t := []rune(normalize(text))
+
+	// Country codes and abbreviations are short and only useful as exact matches;
+	// "id" shouldn't match everything with an "i" and "d".
+	exactOnly := f == FieldCountryCode || f == FieldAbbr
+
+	if slices.Equal(q, t) {
+		return 1, 0, len(text)
+	}
+	if exactOnly {
+		return 0, 0, 0
+	}
+
+	if i := index(t, q); i > -1 {
+		start, end := byteOffset(text, i), byteOffset(text, i+len(q))
+		switch {
+		case i == 0:
+			return 0.9, start, end
+		case !isLetter(t[i-1]):
+			return 0.8, start, end
+		default:
+			return 0.6, start, end
+		}
+	}
+
+	// Allow one typo for every four characters, up to two.
+	maxDist := min(len(q)/4, 2)
+	if maxDist == 0 {
+		return 0, 0, 0
+	}
+	var (
+		bestDist     = maxDist + 1
+		bStart, bEnd int
+	)
+	for _, w := range words(t) {
+		// Also check the same number of words as the query, so that "new yrok"
+		// matches "New York".
+		for end := w[1]; end <= len(t) && end-w[0] <= len(q)+maxDist; end++ {
+			if end < len(t) && isLetter(t[end]) {
+				continue
+			}
+			if d := distance(q, t[w[0]:end]); d < bestDist {
+				bestDist, bStart, bEnd = d, w[0], end
+			}
+		}
+	}
+	if bestDist > maxDist {
+		return 0, 0, 0
+	}
+	return 0.5 - 0.1*float64(bestDist), byteOffset(text, bStart), byteOffset(text, bEnd)
+}
+
+// words gets the rune offsets of the start and end of all words in t.
+func words(t []rune) [][2]int {
+	var (
+		r     [][2]int
+		start = -1
+	)
+	for i, c := range t {
+		switch {
+		case isLetter(c) && start == -1:
+			start = i
+		case !isLetter(c) && start > -1:
+			r = append(r, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start > -1 {
+		r = append(r, [2]int{start, len(t)})
+	}
+	return r
+}
+
+// distance gets the Damerau-Levenshtein distance between a and b (optimal string
+// alignment variant).
+func distance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func index(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if slices.Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// byteOffset converts the rune offset n to a byte offset in s.
+func byteOffset(s string, n int) int {
+	i := 0
+	for ; n > 0 && i < len(s); n-- {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return i
+}
+
+func isLetter(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+// normalize lower-cases s, replaces _ with a space, and removes diacritics.
+//
+// Every rune is replaced with exactly one rune, so that rune offsets in the
+// result are the same as in s.
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' {
+			return ' '
+		}
+		if f, ok := fold[r]; ok {
+			r = f
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
+
+// fold maps letters with diacritics to the letter without.
+var fold = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for _, l := range []string{
+		"AÀÁÂÃÄÅĀĂĄ", "aàáâãäåāăą", "CÇĆĈĊČ", "cçćĉċč", "DĎĐ", "dďđ",
+		"EÈÉÊËĒĔĖĘĚ", "eèéêëēĕėęě", "GĜĞĠĢ", "gĝğġģ", "HĤĦ", "hĥħ",
+		"IÌÍÎÏĨĪĬĮİ", "iìíîïĩīĭįı", "JĴ", "jĵ", "KĶ", "kķ", "LĹĻĽĿŁ", "lĺļľŀł",
+		"NÑŃŅŇ", "nñńņň", "OÒÓÔÕÖØŌŎŐ", "oòóôõöøōŏő", "RŔŖŘ", "rŕŗř",
+		"SŚŜŞŠȘ", "sśŝşšșß", "TŢŤŦȚ", "tţťŧț", "UÙÚÛÜŨŪŬŮŰŲ", "uùúûüũūŭůűų",
+		"WŴ", "wŵ", "YÝŶŸ", "yýÿŷ", "ZŹŻŽ", "zźżž", "'‘’ʼ",
+	} {
+		base, size := utf8.DecodeRuneInString(l)
+		for _, r := range l[size:] {
+			m[r] = base
+		}
+	}
+	return m
+}()
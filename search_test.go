@@ -0,0 +1,63 @@
+package tz
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"makassar", "ID.Asia/Makassar city 1.00 [Makassar]"},
+		{"Asia/Makassar", "ID.Asia/Makassar zone 0.95 [Asia/Makassar]"},
+		{"maka", "ID.Asia/Makassar city 0.90 [Maka]"},
+		{"new york", "US.America/New_York city 1.00 [New York]"},
+		{"york", "US.America/New_York city 0.80 [York]"},
+		{"WITA", "ID.Asia/Makassar abbr 0.90 [WITA]"},
+		{"indonesia", "ID.Asia/Jakarta country 0.90 [Indonesia]"},
+		{"ID", "ID.Asia/Jakarta country_code 0.85 [ID]"},
+		{"sulawesi", "ID.Asia/Makassar comments 0.56 [Sulawesi]"},
+		{"tucuman", "AR.America/Argentina/Tucuman city 1.00 [Tucuman]"},
+		{"Tucumán", "AR.America/Argentina/Tucuman city 1.00 [Tucuman]"},
+		{"côte", "CI.Africa/Abidjan country 0.81 [Côte]"},
+		{"amsterdam", "NL.Europe/Brussels city 1.00 [Amsterdam]"},
+		{"amsterdma", "NL.Europe/Brussels city 0.40 [Amsterdam]"},
+		{"saigon", "VN.Asia/Ho_Chi_Minh city 1.00 [Saigon]"},
+		{"kiev", "UA.Europe/Kyiv city 1.00 [Kiev]"},
+		{" makassar", "ID.Asia/Makassar city 1.00 [Makassar]"},
+		{"makassar\t", "ID.Asia/Makassar city 1.00 [Makassar]"},
+		{"rondonia", "BR.America/Porto_Velho comments 0.70 [Rondônia]"},
+		{"makasar", "ID.Asia/Makassar city 0.40 [Makassar]"},
+		{"new yrok", "US.America/New_York city 0.40 [New York]"},
+		{"", ""},
+		{"  ", ""},
+		{"xxxxxxxxxxxx", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			var have string
+			if m := Search(tt.query, 1); len(m) > 0 {
+				have = fmt.Sprintf("%s %s %.2f [%s]", m[0].Zone, m[0].Field, m[0].Score, m[0].Text[m[0].Start:m[0].End])
+			}
+			if have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	if have := len(Search("america", 0)); have < 100 {
+		t.Errorf("len is %d", have)
+	}
+	if have := len(Search("america", 5)); have != 5 {
+		t.Errorf("len is %d", have)
+	}
+	m := Search("indonesia", 0)
+	if len(m) != 4 {
+		t.Errorf("len is %d", len(m))
+	}
+}
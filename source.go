@@ -0,0 +1,99 @@
+package tz
+
+import (
+	"archive/zip"
+	"errors"
+	"io/fs"
+	"sync"
+	"time"
+)
+
+// Loader loads a time.Location for a zone name.
+type Loader interface {
+	Load(zone string) (*time.Location, error)
+}
+
+// LoaderFunc is an adapter to use a function as a Loader.
+type LoaderFunc func(zone string) (*time.Location, error)
+
+// Load calls f(zone).
+func (f LoaderFunc) Load(zone string) (*time.Location, error) { return f(zone) }
+
+// System loads zones with time.LoadLocation(), which uses the system's zoneinfo
+// and the $ZONEINFO environment variable.
+//
+// This will fall back to Go's embedded tzdata if the system doesn't have the
+// zone and the program imports time/tzdata or zgo.at/tz/tzembed, or is built
+// with "-tags timetzdata". Use zgo.at/tz/tzembed.Loader() to always use the
+// embedded tzdata.
+//
+// This is the default.
+var System Loader = LoaderFunc(time.LoadLocation)
+
+// FS loads zones from a zoneinfo directory, such as os.DirFS("/usr/share/zoneinfo")
+// or an embed.FS.
+func FS(fsys fs.FS) Loader {
+	return LoaderFunc(func(zone string) (*time.Location, error) {
+		if !fs.ValidPath(zone) {
+			return nil, errors.New("unknown time zone " + zone)
+		}
+		data, err := fs.ReadFile(fsys, zone)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, errors.New("unknown time zone " + zone)
+			}
+			return nil, err
+		}
+		return time.LoadLocationFromTZData(zone, data)
+	})
+}
+
+// Zip loads zones from a zoneinfo.zip file, such as the one in
+// $GOROOT/lib/time/zoneinfo.zip.
+//
+// The file is kept open until the program exits.
+func Zip(path string) (Loader, error) {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	return FS(z), nil
+}
+
+// Fallback loads zones from the first Loader that doesn't return an error.
+//
+// The error from the last loader is returned if all loaders fail.
+func Fallback(loaders ...Loader) Loader {
+	return LoaderFunc(func(zone string) (*time.Location, error) {
+		var err error
+		for _, l := range loaders {
+			var loc *time.Location
+			loc, err = l.Load(zone)
+			if err == nil {
+				return loc, nil
+			}
+		}
+		if err == nil {
+			err = errors.New("unknown time zone " + zone)
+		}
+		return nil, err
+	})
+}
+
+var source = System
+
+// SetSource sets the Loader to load the time.Location for the Zones with.
+//
+// This will (re-)load the locations for all Zones, and is not safe to call
+// concurrently with anything else in this package. It's intended to be called
+// once on startup.
+//
+// The default of System is used if l is nil.
+func SetSource(l Loader) {
+	if l == nil {
+		l = System
+	}
+	source = l
+	loadLocationOnce = sync.Once{}
+	loadLocations()
+}
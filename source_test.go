@@ -0,0 +1,131 @@
+package tz
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestSetSource(t *testing.T) {
+	defer func(f func(*LoadError)) { OnLoadError = f }(OnLoadError)
+	defer SetSource(nil)
+	OnLoadError = nil
+
+	jul := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	check := func(t *testing.T, wantAmsterdam, wantMakassar int) {
+		t.Helper()
+		if have := MustNew("NL", "").OffsetAt(jul); have != wantAmsterdam {
+			t.Errorf("NL: have %d; want %d", have, wantAmsterdam)
+		}
+		if have := MustNew("ID", "Asia/Makassar").OffsetAt(jul); have != wantMakassar {
+			t.Errorf("Makassar: have %d; want %d", have, wantMakassar)
+		}
+	}
+
+	var (
+		errFail = errors.New("fail")
+		fail    = LoaderFunc(func(string) (*time.Location, error) { return nil, errFail })
+		onlyEU  = LoaderFunc(func(zone string) (*time.Location, error) {
+			if filepath.Dir(zone) != "Europe" {
+				return nil, errFail
+			}
+			return time.LoadLocation(zone)
+		})
+	)
+
+	t.Run("fail", func(t *testing.T) {
+		SetSource(fail)
+		check(t, 0, 0)
+	})
+	t.Run("onlyEU", func(t *testing.T) {
+		SetSource(onlyEU)
+		check(t, 120, 0)
+	})
+	t.Run("fallback", func(t *testing.T) {
+		SetSource(Fallback(fail, onlyEU, System))
+		check(t, 120, 480)
+	})
+	t.Run("FS", func(t *testing.T) {
+		if _, err := os.Stat("/usr/share/zoneinfo/Europe/Brussels"); err != nil {
+			t.Skip(err)
+		}
+		SetSource(FS(os.DirFS("/usr/share/zoneinfo")))
+		check(t, 120, 480)
+	})
+	t.Run("Zip", func(t *testing.T) {
+		l, err := Zip(filepath.Join(runtime.GOROOT(), "lib/time/zoneinfo.zip"))
+		if err != nil {
+			t.Skip(err)
+		}
+		SetSource(l)
+		check(t, 120, 480)
+
+		if _, err := l.Load("Asia/Denpasar"); err == nil {
+			t.Error("err is nil")
+		}
+		if _, err := l.Load("../Asia/Makassar"); err == nil {
+			t.Error("err is nil")
+		}
+	})
+	t.Run("System", func(t *testing.T) {
+		SetSource(nil)
+		check(t, 120, 480)
+	})
+}
+
+func TestLoadErrors(t *testing.T) {
+	defer func(f func(*LoadError)) { OnLoadError = f }(OnLoadError)
+	defer SetSource(nil)
+
+	var logged []string
+	OnLoadError = func(err *LoadError) { logged = append(logged, err.Zone) }
+
+	errFail := errors.New("fail")
+	SetSource(LoaderFunc(func(zone string) (*time.Location, error) {
+		if zone == "Asia/Makassar" || zone == "Europe/Brussels" {
+			return nil, errFail
+		}
+		return time.LoadLocation(zone)
+	}))
+
+	err := Init()
+	if !errors.Is(err, errFail) {
+		t.Fatalf("wrong error: %#v", err)
+	}
+	var lErr *LoadError
+	if !errors.As(err, &lErr) {
+		t.Fatalf("wrong error: %#v", err)
+	}
+	if lErr.Zone != "Europe/Brussels" && lErr.Zone != "Asia/Makassar" {
+		t.Errorf("wrong zone: %q", lErr.Zone)
+	}
+
+	// Brussels is listed three times, but should only be reported once.
+	if have := len(LoadErrors()); have != 2 {
+		t.Errorf("len(LoadErrors()) = %d", have)
+	}
+	if have := len(logged); have != 2 {
+		t.Errorf("len(logged) = %d", have)
+	}
+
+	if z := MustNew("ID", "Asia/Makassar"); z.Loaded() || z.Loc() != time.UTC {
+		t.Error("Asia/Makassar is loaded")
+	}
+	if z := MustNew("ID", "Asia/Jakarta"); !z.Loaded() || z.Loc() == time.UTC {
+		t.Error("Asia/Jakarta is not loaded")
+	}
+
+	SetSource(nil)
+	if err := Init(); err != nil {
+		t.Errorf("error after SetSource(nil): %s", err)
+	}
+	if have := len(LoadErrors()); have != 0 {
+		t.Errorf("len(LoadErrors()) = %d", have)
+	}
+	if MustNew("BE", "Europe/Brussels").Location != MustNew("NL", "Europe/Brussels").Location {
+		t.Error("Location not shared")
+	}
+}
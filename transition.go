@@ -0,0 +1,98 @@
+package tz
+
+import (
+	"time"
+)
+
+// Transition is a change in the offset or abbreviation of a zone, such as the
+// start or end of DST.
+type Transition struct {
+	At time.Time // Instant the transition takes effect, in UTC.
+
+	OffsetBefore time.Duration // 1h
+	OffsetAfter  time.Duration // 2h
+	AbbrBefore   string        // CET
+	AbbrAfter    string        // CEST
+	DST          bool          // DST is in effect after the transition.
+}
+
+// Transitions gets all transitions in the zone between from and to; from is
+// inclusive and to is exclusive.
+func (t *Zone) Transitions(from, to time.Time) []Transition {
+	var (
+		r  []Transition
+		at = from.Add(-1)
+	)
+	for {
+		tr, ok := t.NextTransition(at)
+		if !ok || !tr.At.Before(to) {
+			return r
+		}
+		r = append(r, tr)
+		at = tr.At
+	}
+}
+
+// NextTransition gets the first transition after tt.
+//
+// Returns false if there are no further transitions, for example because the
+// zone doesn't observe DST (anymore).
+func (t *Zone) NextTransition(tt time.Time) (Transition, bool) {
+	loc := t.Loc()
+	for {
+		_, end := tt.In(loc).ZoneBounds()
+		if end.IsZero() {
+			return Transition{}, false
+		}
+		// After the last transition in the tzdata file ZoneBounds() returns an
+		// end before tt on the last day of leap years (2040-12-31); skip the day.
+		if !end.After(tt) {
+			tt = tt.Add(24 * time.Hour)
+			continue
+		}
+		if tr := newTransition(loc, end); tr.changed() {
+			return tr, true
+		}
+		tt = end
+	}
+}
+
+// PrevTransition gets the last transition before tt.
+//
+// Returns false if there are no earlier transitions.
+func (t *Zone) PrevTransition(tt time.Time) (Transition, bool) {
+	loc := t.Loc()
+	for {
+		start, _ := tt.Add(-1).In(loc).ZoneBounds()
+		if start.IsZero() {
+			return Transition{}, false
+		}
+		if tr := newTransition(loc, start); tr.changed() {
+			return tr, true
+		}
+		tt = start
+	}
+}
+
+func newTransition(loc *time.Location, at time.Time) Transition {
+	var (
+		before                = at.Add(-1).In(loc)
+		after                 = at.In(loc)
+		abbrBefore, offBefore = before.Zone()
+		abbrAfter, offAfter   = after.Zone()
+	)
+	return Transition{
+		At:           at.UTC(),
+		OffsetBefore: time.Duration(offBefore) * time.Second,
+		OffsetAfter:  time.Duration(offAfter) * time.Second,
+		AbbrBefore:   abbrBefore,
+		AbbrAfter:    abbrAfter,
+		DST:          after.IsDST(),
+	}
+}
+
+// tzdata sometimes has transitions that change nothing we can observe (e.g.
+// only the rules or the zone's name in the database changed), so skip those.
+func (tr Transition) changed() bool {
+	return tr.OffsetBefore != tr.OffsetAfter || tr.AbbrBefore != tr.AbbrAfter
+}
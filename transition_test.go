@@ -0,0 +1,101 @@
+package tz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTransitions(t *testing.T) {
+	tests := []struct {
+		zone     string
+		from, to time.Time
+		want     []string
+	}{
+		{"Europe/Amsterdam",
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{
+				"2024-03-31 01:00:00 +0000 UTC 1h0m0s→2h0m0s CET→CEST dst=true",
+				"2024-10-27 01:00:00 +0000 UTC 2h0m0s→1h0m0s CEST→CET dst=false",
+			}},
+		{"America/Sao_Paulo",
+			time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{
+				"2018-02-18 02:00:00 +0000 UTC -2h0m0s→-3h0m0s -02→-03 dst=false",
+				"2018-11-04 03:00:00 +0000 UTC -3h0m0s→-2h0m0s -03→-02 dst=true",
+				"2019-02-17 02:00:00 +0000 UTC -2h0m0s→-3h0m0s -02→-03 dst=false",
+			}},
+		{"Asia/Makassar",
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			nil},
+		{"UTC",
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			nil},
+
+		// Past the last transition in the tzdata file, across the end of a leap
+		// year.
+		{"Europe/Amsterdam",
+			time.Date(2040, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2041, 6, 1, 0, 0, 0, 0, time.UTC),
+			[]string{
+				"2040-10-28 01:00:00 +0000 UTC 2h0m0s→1h0m0s CEST→CET dst=false",
+				"2041-03-31 01:00:00 +0000 UTC 1h0m0s→2h0m0s CET→CEST dst=true",
+			}},
+
+		// from is inclusive, to is exclusive.
+		{"Europe/Amsterdam",
+			time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC), time.Date(2024, 10, 27, 1, 0, 0, 0, time.UTC),
+			[]string{
+				"2024-03-31 01:00:00 +0000 UTC 1h0m0s→2h0m0s CET→CEST dst=true",
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			var have []string
+			for _, tr := range MustNew("", tt.zone).Transitions(tt.from, tt.to) {
+				have = append(have, fmt.Sprintf("%s %s→%s %s→%s dst=%t",
+					tr.At, tr.OffsetBefore, tr.OffsetAfter, tr.AbbrBefore, tr.AbbrAfter, tr.DST))
+			}
+			if fmt.Sprint(have) != fmt.Sprint(tt.want) {
+				t.Errorf("\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextPrevTransition(t *testing.T) {
+	z := MustNew("", "Europe/Amsterdam")
+	at := time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		f    func(time.Time) (Transition, bool)
+		in   time.Time
+		want time.Time
+	}{
+		{"next", z.NextTransition, at.Add(-time.Hour), at},
+		{"next at", z.NextTransition, at, time.Date(2024, 10, 27, 1, 0, 0, 0, time.UTC)},
+		{"prev", z.PrevTransition, at.Add(time.Hour), at},
+		{"prev at", z.PrevTransition, at, time.Date(2023, 10, 29, 1, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, ok := tt.f(tt.in)
+			if !ok {
+				t.Fatal("ok is false")
+			}
+			if !tr.At.Equal(tt.want) {
+				t.Errorf("\nhave: %s\nwant: %s", tr.At, tt.want)
+			}
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		if _, ok := MustNew("", "Asia/Makassar").NextTransition(at); ok {
+			t.Error("NextTransition: ok is true")
+		}
+		if _, ok := UTC.PrevTransition(at); ok {
+			t.Error("PrevTransition: ok is true")
+		}
+	})
+}
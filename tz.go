@@ -1,14 +1,13 @@
-//go:generate sh -c "go run gen.go | gofmt >list.go"
+//go:generate go run gen.go
 
 // Package tz contains timezone lists.
 package tz
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
-	"math"
 	"os"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +35,8 @@ type Zone struct {
 	Abbr        []string // WITA – the correct abbreviation may change depending on the time of year (i.e. CET and CEST, depending on DST).
 	CountryName string   // Indonesia
 	Comments    string   // Borneo (east, south); Sulawesi/Celebes, Bali, Nusa Tengarra; Timor (west)
+	Latitude    float64  // -5.1167 – coordinates of the principal location (e.g. the city in the zone name).
+	Longitude   float64  // 119.4
 
 	display string // cached Display()
 }
@@ -44,91 +45,108 @@ type Zone struct {
 var UTC = &Zone{CountryCode: "", Zone: "UTC", Abbr: []string{"UTC"},
 	CountryName: "UTC", Comments: "", Location: time.UTC}
 
-var loadLocationOnce sync.Once
+var (
+	loadLocationOnce sync.Once
+	loadErrors       []error
+)
 
-// New timezone from country code and zone name. The country code is only
-// informative, and may be blank or wrong, in which case it will load the first
-// zone found.
-func New(ccode, zone string) (*Zone, error) {
-	// Add time.Location to all the zones. This is about 68k memory without the
-	// loaded zones, and 670k with. Not super huge, but kinda large. Also takes
-	// about 12ms on my laptop.
+// LoadError is used if the time.Location for a zone can't be loaded.
+type LoadError struct {
+	Zone string // Asia/Makassar
+	Err  error
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("zgo.at/tz: loading %q: %s", e.Zone, e.Err)
+}
+
+func (e *LoadError) Unwrap() error { return e.Err }
+
+// OnLoadError is called once for every zone that can't be loaded. The default
+// is to print a warning to stderr; set to nil to disable this.
+//
+// The Location for zones that can't be loaded is nil, which will behave as UTC.
+var OnLoadError = func(err *LoadError) {
+	fmt.Fprintf(os.Stderr, "warning: %s; you probably need to update your tzdata or zoneinfo\n", err)
+}
+
+// Add time.Location to all the zones. This is about 68k memory without the
+// loaded zones, and 670k with. Not super huge, but kinda large. Also takes about
+// 12ms on my laptop.
+func loadLocations() {
 	loadLocationOnce.Do(func() {
+		loadErrors = nil
+		// Zones are listed once for every country, but only need to be loaded
+		// (and reported) once.
+		loaded := make(map[string]*time.Location)
 		for _, z := range Zones {
+			if loc, ok := loaded[z.Zone]; ok {
+				z.Location = loc
+				continue
+			}
 			var err error
-			z.Location, err = time.LoadLocation(z.Zone)
+			z.Location, err = source.Load(z.Zone)
+			loaded[z.Zone] = z.Location
 			if err != nil {
-				if strings.Contains(err.Error(), "unknown time zone") {
-					fmt.Fprintf(os.Stderr, "warning: zgo.at/tz: %s; you probably need to update your tzdata or zoneinfo\n", err)
+				lErr := &LoadError{Zone: z.Zone, Err: err}
+				loadErrors = append(loadErrors, lErr)
+				if OnLoadError != nil {
+					OnLoadError(lErr)
 				}
 			}
 		}
+		buildIndex()
 	})
+}
 
-	if zone == "UTC" {
-		return UTC, nil
-	}
-	if a, ok := aliases[zone]; ok {
-		zone = a
-	}
-	if strings.HasPrefix(zone, "Etc/") {
-		zone = zone[4:]
-		if zone == "UTC" || zone == "GMT" || zone == "Unknown" {
-			return UTC, nil
-		}
-		if !strings.HasPrefix(zone, "GMT") {
-			return nil, fmt.Errorf("invalid Etc/ timezone: %q", zone)
-		}
-		o, err := strconv.ParseInt(zone[3:], 10, 8)
-		if err != nil {
-			return nil, err
-		}
-		off := int(o) * -60 // + and - are reversed in Etc/ listings
+// Init loads the time.Location for all zones, and returns a *LoadError for every
+// zone that couldn't be loaded, combined with errors.Join().
+//
+// This is done automatically when it's first needed; calling Init() is only
+// needed to check for errors.
+func Init() error {
+	loadLocations()
+	return errors.Join(loadErrors...)
+}
 
-		// If we have a country match the first one for this country that
-		// corresponds to the offset.
-		for _, z := range Zones {
-			if z.CountryCode == ccode && z.Offset() == off {
-				return z, nil
-			}
-		}
-		// No matches for this offset, which shouldn't happen, but return the
-		// first for this country.
-		if ccode != "" {
-			for _, z := range Zones {
-				if z.CountryCode == ccode {
-					return z, nil
-				}
-			}
-		}
+// LoadErrors gets all errors from loading the time.Location for zones; every
+// error is a *LoadError.
+func LoadErrors() []error {
+	loadLocations()
+	return loadErrors
+}
 
-		return nil, fmt.Errorf("unknown timezone: %q %q", ccode, zone)
-	}
+// Errors returned by New() and Scan().
+var (
+	ErrUnknownZone   = errors.New("unknown timezone")
+	ErrInvalidEtc    = errors.New("invalid Etc/ timezone")
+	ErrInvalidFormat = errors.New("invalid value")
+)
 
-	// No zone name but country given; just get the first zone for that country,
-	// which is better than nothing.
-	if zone == "" && ccode != "" {
-		for _, z := range Zones {
-			if z.CountryCode == ccode {
-				return z, nil
-			}
-		}
-	}
+// LookupError is returned by New() if the zone can't be found. Err is
+// ErrUnknownZone or ErrInvalidEtc.
+type LookupError struct {
+	CountryCode string
+	Zone        string
+	Err         error
+}
 
-	var match *Zone
-	for _, z := range Zones {
-		if (ccode == "" || z.CountryCode == ccode) && z.Zone == zone {
-			return z, nil
-		}
-		if match == nil && z.Zone == zone {
-			match = z
-		}
-	}
+func (e *LookupError) Error() string {
+	return fmt.Sprintf("%s: %q %q", e.Err, e.CountryCode, e.Zone)
+}
 
-	if match != nil {
-		return match, nil
-	}
-	return nil, fmt.Errorf("unknown timezone: %q %q", ccode, zone)
+func (e *LookupError) Unwrap() error { return e.Err }
+
+// New timezone from country code and zone name. The country code is only
+// informative, and may be blank or wrong, in which case it will load the first
+// zone found.
+//
+// The zone may also be a Windows timezone ID; see FromWindows().
+//
+// Use NewStrict() to reject anything that doesn't match exactly.
+func New(ccode, zone string) (*Zone, error) {
+	z, _, err := resolve(ccode, zone)
+	return z, err
 }
 
 // MustNew behaves like New(), but will panic on errors.
@@ -141,6 +159,8 @@ func MustNew(ccode, zone string) *Zone {
 }
 
 // Loc gets the time.Location, or UTC if it's not set.
+//
+// Use Loaded() to check if the location is set.
 func (t *Zone) Loc() *time.Location {
 	if t == nil || t.Location == nil {
 		return time.UTC
@@ -148,6 +168,12 @@ func (t *Zone) Loc() *time.Location {
 	return t.Location
 }
 
+// Loaded reports if the time.Location is set. It may not be set if it couldn't
+// be loaded; see LoadErrors().
+func (t *Zone) Loaded() bool {
+	return t != nil && t.Location != nil
+}
+
 // Display a human-readable description of the timezone, for e.g. <option>.
 func (t *Zone) Display() string {
 	if t == nil {
@@ -156,24 +182,27 @@ func (t *Zone) Display() string {
 
 	// TODO: this could be aligned better with some spaces.
 	if t.display == "" {
-		var b strings.Builder
-		b.WriteString(t.CountryName)
-		b.WriteString(": ")
-		b.WriteString(t.Zone)
-		if len(t.Abbr) > 0 {
-			b.WriteString(" (")
-			b.WriteString(strings.Join(t.Abbr, ", "))
-			b.WriteString(")")
-		}
-		if t.Comments != "" {
-			b.WriteString(" – ")
-			b.WriteString(t.Comments)
-		}
-		t.display = b.String()
+		t.display = t.CountryName + ": " + t.label()
 	}
 	return t.display
 }
 
+// label is like Display(), but without the country name.
+func (t *Zone) label() string {
+	var b strings.Builder
+	b.WriteString(t.Zone)
+	if len(t.Abbr) > 0 {
+		b.WriteString(" (")
+		b.WriteString(strings.Join(t.Abbr, ", "))
+		b.WriteString(")")
+	}
+	if t.Comments != "" {
+		b.WriteString(" – ")
+		b.WriteString(t.Comments)
+	}
+	return b.String()
+}
+
 // String is an unique representation for this timezone.
 func (t *Zone) String() string {
 	if t == nil {
@@ -191,34 +220,42 @@ func (t *Zone) UnmarshalText(v []byte) error {
 }
 
 // Offset gets the timezone offset in minutes.
-func (t *Zone) Offset() int {
+//
+// Note that this is the offset that is currently valid. Use OffsetAt() to get
+// the offset for a specific time.
+func (t *Zone) Offset() int { return t.OffsetAt(time.Now()) }
+
+// OffsetAt gets the timezone offset in minutes at the time tt.
+func (t *Zone) OffsetAt(tt time.Time) int {
 	if t == nil || t.Location == nil {
 		return 0
 	}
-	now := time.Now().In(t.Location)
-	_, offset := now.Zone()
+	_, offset := tt.In(t.Location).Zone()
 	return offset / 60
 }
 
 // OffsetDuration gets the timezone offset.
-func (t *Zone) OffsetDuration() time.Duration {
-	if t == nil || t.Location == nil {
-		return 0
-	}
-	return time.Duration(t.Offset()) * time.Minute
+func (t *Zone) OffsetDuration() time.Duration { return t.OffsetDurationAt(time.Now()) }
+
+// OffsetDurationAt gets the timezone offset at the time tt.
+func (t *Zone) OffsetDurationAt(tt time.Time) time.Duration {
+	return time.Duration(t.OffsetAt(tt)) * time.Minute
 }
 
 // OffsetRFC3339 gets the offset as a RFC3339 string: "+08:00", "-07:30", "UTC".
 //
 // Note that this displays the offset that is currently valid. For example
 // Europe/Berlin may be +0100 or +0200, depending on whether DST is in effect.
-func (t *Zone) OffsetRFC3339() string {
-	o := t.Offset()
+func (t *Zone) OffsetRFC3339() string { return t.OffsetRFC3339At(time.Now()) }
+
+// OffsetRFC3339At gets the offset at the time tt as a RFC3339 string: "+08:00",
+// "-07:30", "UTC".
+func (t *Zone) OffsetRFC3339At(tt time.Time) string {
+	o := t.OffsetAt(tt)
 	if o == 0 {
 		return "UTC"
 	}
-	m := o % 60
-	return fmt.Sprintf("%+03.0f:%02d", math.Floor(float64(o)/60), m)
+	return fmt.Sprintf("%s%02d:%02d", sign(o), abs(o)/60, abs(o)%60)
 }
 
 // OffsetDisplay gets the offset as a human readable string: "UTC +8:00", "UTC
@@ -226,13 +263,43 @@ func (t *Zone) OffsetRFC3339() string {
 //
 // Note that this displays the offset that is currently valid. For example
 // Europe/Berlin may be +0100 or +0200, depending on whether DST is in effect.
-func (t *Zone) OffsetDisplay() string {
-	o := t.Offset()
+func (t *Zone) OffsetDisplay() string { return t.OffsetDisplayAt(time.Now()) }
+
+// OffsetDisplayAt gets the offset at the time tt as a human readable string:
+// "UTC +8:00", "UTC -7:30", "UTC".
+func (t *Zone) OffsetDisplayAt(tt time.Time) string {
+	o := t.OffsetAt(tt)
 	if o == 0 {
 		return "UTC"
 	}
-	m := o % 60
-	return fmt.Sprintf("UTC %+.0f:%02d", math.Floor(float64(o)/60), m)
+	return fmt.Sprintf("UTC %s%d:%02d", sign(o), abs(o)/60, abs(o)%60)
+}
+
+// AbbrAt gets the abbreviation that is in use at the time tt, such as "CET" or
+// "CEST" for Europe/Berlin.
+//
+// Zones without an abbreviation will use the offset, such as "+08". This
+// returns "UTC" if the location isn't set.
+func (t *Zone) AbbrAt(tt time.Time) string {
+	if t == nil || t.Location == nil {
+		return "UTC"
+	}
+	name, _ := tt.In(t.Location).Zone()
+	return name
+}
+
+func sign(n int) string {
+	if n < 0 {
+		return "-"
+	}
+	return "+"
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
 }
 
 // Value implements the SQL Value function to determine what to store in the DB.
@@ -252,9 +319,16 @@ func (t *Zone) Scan(v any) error {
 	case []byte:
 		vv = string(x)
 	}
+	if _, ok := windowsZones[vv]; ok { // "W. Europe Standard Time" has a "."
+		z, err := New("", vv)
+		if z != nil {
+			*t = *z
+		}
+		return err
+	}
 	ccode, zone, ok := strings.Cut(vv, ".")
 	if !ok {
-		return fmt.Errorf("invalid value: %q", vv)
+		return fmt.Errorf("%w: %q", ErrInvalidFormat, vv)
 	}
 
 	z, err := New(ccode, zone)
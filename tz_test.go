@@ -1,6 +1,7 @@
 package tz
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -71,35 +72,64 @@ func TestNew(t *testing.T) {
 }
 
 func TestOffset(t *testing.T) {
+	winter := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		in           *Zone
+		at           time.Time
 		want         int
 		wantDuration time.Duration
 		wantRFC      string
 		wantDisplay  string
+		wantAbbr     string
 	}{
-		{nil, 0, 0, "UTC", "UTC"},
-		{MustNew("", "UTC"), 0, 0, "UTC", "UTC"},
-		{MustNew("", "America/Sao_Paulo"), -180, -3 * time.Hour, "-03:00", "UTC -3:00"},
-		{MustNew("", "Australia/Darwin"), 570, 570 * time.Minute, "+09:30", "UTC +9:30"},
+		{nil, winter, 0, 0, "UTC", "UTC", "UTC"},
+		{MustNew("", "UTC"), winter, 0, 0, "UTC", "UTC", "UTC"},
+		{MustNew("", "America/Sao_Paulo"), winter, -180, -3 * time.Hour, "-03:00", "UTC -3:00", "-03"},
+		{MustNew("", "Australia/Darwin"), winter, 570, 570 * time.Minute, "+09:30", "UTC +9:30", "ACST"},
+		{MustNew("", "Europe/Berlin"), winter, 60, time.Hour, "+01:00", "UTC +1:00", "CET"},
+		{MustNew("", "Europe/Berlin"), summer, 120, 2 * time.Hour, "+02:00", "UTC +2:00", "CEST"},
+		{MustNew("", "America/St_Johns"), winter, -210, -210 * time.Minute, "-03:30", "UTC -3:30", "NST"},
+		{MustNew("", "America/St_Johns"), summer, -150, -150 * time.Minute, "-02:30", "UTC -2:30", "NDT"},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.in.String(), func(t *testing.T) {
-			if have := tt.in.Offset(); have != tt.want {
+		t.Run(tt.in.String()+tt.at.Format("/Jan"), func(t *testing.T) {
+			if have := tt.in.OffsetAt(tt.at); have != tt.want {
 				t.Errorf("\nhave: %v\nwant: %v", have, tt.want)
 			}
-			if have := tt.in.OffsetDuration(); have != tt.wantDuration {
+			if have := tt.in.OffsetDurationAt(tt.at); have != tt.wantDuration {
 				t.Errorf("\nhave: %v\nwant: %v", have, tt.wantDuration)
 			}
-			if have := tt.in.OffsetRFC3339(); have != tt.wantRFC {
+			if have := tt.in.OffsetRFC3339At(tt.at); have != tt.wantRFC {
 				t.Errorf("\nhave: %v\nwant: %v", have, tt.wantRFC)
 			}
-			if have := tt.in.OffsetDisplay(); have != tt.wantDisplay {
+			if have := tt.in.OffsetDisplayAt(tt.at); have != tt.wantDisplay {
 				t.Errorf("\nhave: %v\nwant: %v", have, tt.wantDisplay)
 			}
+			if have := tt.in.AbbrAt(tt.at); have != tt.wantAbbr {
+				t.Errorf("\nhave: %v\nwant: %v", have, tt.wantAbbr)
+			}
 		})
 	}
+
+	t.Run("now", func(t *testing.T) {
+		z := MustNew("", "Europe/Berlin")
+		now := time.Now()
+		if have, want := z.Offset(), z.OffsetAt(now); have != want {
+			t.Errorf("\nhave: %v\nwant: %v", have, want)
+		}
+		if have, want := z.OffsetDuration(), z.OffsetDurationAt(now); have != want {
+			t.Errorf("\nhave: %v\nwant: %v", have, want)
+		}
+		if have, want := z.OffsetRFC3339(), z.OffsetRFC3339At(now); have != want {
+			t.Errorf("\nhave: %v\nwant: %v", have, want)
+		}
+		if have, want := z.OffsetDisplay(), z.OffsetDisplayAt(now); have != want {
+			t.Errorf("\nhave: %v\nwant: %v", have, want)
+		}
+	})
 }
 
 func errorContains(out error, want string) bool {
@@ -111,3 +141,178 @@ func errorContains(out error, want string) bool {
 	}
 	return strings.Contains(out.Error(), want)
 }
+
+func TestCoordinates(t *testing.T) {
+	tests := []struct {
+		in       string
+		lat, lon float64
+	}{
+		{"Asia/Makassar", -5.1167, 119.4},       // ±DDMM±DDDMM
+		{"America/New_York", 40.7142, -74.0064}, // ±DDMMSS±DDDMMSS
+		{"Europe/Brussels", 50.8333, 4.3333},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			z := MustNew("", tt.in)
+			if z.Latitude != tt.lat || z.Longitude != tt.lon {
+				t.Errorf("\nhave: %v, %v\nwant: %v, %v", z.Latitude, z.Longitude, tt.lat, tt.lon)
+			}
+		})
+	}
+
+	for _, z := range Zones {
+		if z.Latitude == 0 || z.Longitude == 0 {
+			t.Errorf("no coordinates for %s", z)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		inC, inZ string
+		want     error
+	}{
+		{"ID", "Asia/Denpasar", ErrUnknownZone},
+		{"", "Etc/GMT-10", ErrUnknownZone},
+		{"", "Etc/XXX", ErrInvalidEtc},
+		{"", "Etc/GMT+XX", ErrInvalidEtc},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.inC+tt.inZ, func(t *testing.T) {
+			_, err := New(tt.inC, tt.inZ)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("wrong error: %#v", err)
+			}
+			var lErr *LookupError
+			if !errors.As(err, &lErr) {
+				t.Fatalf("not a LookupError: %#v", err)
+			}
+			if lErr.CountryCode != tt.inC || lErr.Zone != tt.inZ {
+				t.Errorf("wrong fields: %#v", lErr)
+			}
+
+			var z Zone
+			err = z.Scan(tt.inC + "." + tt.inZ)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("wrong error from Scan: %#v", err)
+			}
+		})
+	}
+
+	t.Run("invalid format", func(t *testing.T) {
+		var z Zone
+		err := z.Scan("Asia/Makassar")
+		if !errors.Is(err, ErrInvalidFormat) {
+			t.Fatalf("wrong error: %#v", err)
+		}
+	})
+}
+
+func TestNewStrict(t *testing.T) {
+	tests := []struct {
+		inC, inZ     string
+		want         string
+		wantFallback string
+		wantErr      error
+	}{
+		{"ID", "Asia/Makassar", "ID.Asia/Makassar", "", nil},
+		{"", "Asia/Makassar", "ID.Asia/Makassar", "", nil},
+		{"", "UTC", ".UTC", "", nil},
+
+		{"NL", "Asia/Makassar", "ID.Asia/Makassar", "country mismatch", ErrNotExact},
+		{"NL", "", "NL.Europe/Brussels", "country default", ErrNotExact},
+		{"VN", "Asia/Saigon", "VN.Asia/Ho_Chi_Minh", "alias", ErrNotExact},
+		{"NL", "Asia/Saigon", "VN.Asia/Ho_Chi_Minh", "country mismatch", ErrNotExact},
+		{"SG", "Etc/GMT-8", "SG.Asia/Singapore", "Etc/ offset", ErrNotExact},
+		{"SG", "Etc/GMT-10", "SG.Asia/Singapore", "country default", ErrNotExact},
+		{"", "Etc/UTC", ".UTC", "UTC fallback", ErrNotExact},
+		{"", "Zulu", ".UTC", "UTC fallback", ErrNotExact},
+
+		{"ID", "Asia/Denpasar", "", "", ErrUnknownZone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.inC+tt.inZ, func(t *testing.T) {
+			z, err := NewStrict(tt.inC, tt.inZ)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrong error: %#v", err)
+			}
+
+			if tt.wantErr == nil {
+				if z.String() != tt.want {
+					t.Errorf("\nhave: %s\nwant: %s", z, tt.want)
+				}
+				return
+			}
+			if z != nil {
+				t.Errorf("zone is not nil: %s", z)
+			}
+			var sErr *StrictError
+			if errors.As(err, &sErr) {
+				if sErr.Match.String() != tt.want || sErr.Method.String() != tt.wantFallback {
+					t.Errorf("\nhave: %s %s\nwant: %s %s", sErr.Match, sErr.Method, tt.want, tt.wantFallback)
+				}
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		inC, inZ string
+		want     string
+		wantM    Method
+	}{
+		{"ID", "Asia/Makassar", "ID.Asia/Makassar", Exact},
+		{"", "Asia/Makassar", "ID.Asia/Makassar", Exact},
+		{"GB", "UTC", ".UTC", Exact},
+		{"VN", "Asia/Saigon", "VN.Asia/Ho_Chi_Minh", Alias},
+		{"", "Asia/Saigon", "VN.Asia/Ho_Chi_Minh", Alias},
+		{"SG", "Etc/GMT-8", "SG.Asia/Singapore", EtcOffset},
+		{"SG", "Etc/GMT-10", "SG.Asia/Singapore", CountryDefault},
+		{"NL", "", "NL.Europe/Brussels", CountryDefault},
+		{"NL", "Asia/Makassar", "ID.Asia/Makassar", CountryMismatch},
+		{"NL", "Asia/Saigon", "VN.Asia/Ho_Chi_Minh", CountryMismatch},
+		{"", "Etc/UTC", ".UTC", UTCFallback},
+		{"", "Etc/Unknown", ".UTC", UTCFallback},
+		{"", "Zulu", ".UTC", UTCFallback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.inC+tt.inZ, func(t *testing.T) {
+			z, r, err := Resolve(tt.inC, tt.inZ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if z.String() != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", z, tt.want)
+			}
+			want := Resolution{Method: tt.wantM, CountryCode: tt.inC, Zone: tt.inZ}
+			if r != want {
+				t.Errorf("\nhave: %#v\nwant: %#v", r, want)
+			}
+		})
+	}
+}
+
+func TestAliases(t *testing.T) {
+	tests := []struct {
+		zone *Zone
+		want string
+	}{
+		{MustNew("", "Asia/Ho_Chi_Minh"), "Asia/Saigon"},
+		{MustNew("", "Asia/Makassar"), "Asia/Ujung_Pandang"},
+		{UTC, "Etc/UCT Etc/UTC Etc/Universal Etc/Zulu UCT Universal Zulu"},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone.String(), func(t *testing.T) {
+			if have := strings.Join(tt.zone.Aliases(), " "); have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
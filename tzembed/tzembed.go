@@ -0,0 +1,47 @@
+// Package tzembed embeds Go's copy of the tzdata in the program.
+//
+// Import it for side effects to load zones from the embedded data if the
+// system doesn't have a zoneinfo database, such as in containers built from
+// "scratch":
+//
+//	import _ "zgo.at/tz/tzembed"
+//
+// This adds about 450K to the binary. The system's zoneinfo is still preferred
+// if it exists, as that's what time.LoadLocation() does. This is the same as
+// importing time/tzdata.
+//
+// Use Loader() to always use the embedded data, for example if the system's
+// zoneinfo may be outdated:
+//
+//	tz.SetSource(tzembed.Loader())
+package tzembed
+
+//go:generate sh -c "cp \"$(go env GOROOT)/lib/time/zoneinfo.zip\" ."
+
+import (
+	"archive/zip"
+	_ "embed"
+	"strings"
+	_ "time/tzdata"
+
+	"zgo.at/tz"
+)
+
+// zoneinfo is a copy of $GOROOT/lib/time/zoneinfo.zip, which is also what
+// time/tzdata is generated from; time/tzdata doesn't export its data.
+//
+//go:embed zoneinfo.zip
+var zoneinfo string
+
+// Loader gets a tz.Loader that loads zones from the embedded data, ignoring the
+// system's zoneinfo.
+//
+// This uses its own copy of the data, which adds another 400K to the binary if
+// Loader() is used.
+func Loader() tz.Loader {
+	z, err := zip.NewReader(strings.NewReader(zoneinfo), int64(len(zoneinfo)))
+	if err != nil {
+		panic("tzembed: " + err.Error()) // Only if zoneinfo.zip is corrupt.
+	}
+	return tz.FS(z)
+}
@@ -0,0 +1,26 @@
+package tzembed_test
+
+import (
+	"testing"
+	"time"
+
+	"zgo.at/tz"
+	"zgo.at/tz/tzembed"
+)
+
+func TestLoader(t *testing.T) {
+	defer tz.SetSource(nil)
+	tz.SetSource(tzembed.Loader())
+
+	if errs := tz.LoadErrors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	jul := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	if have := tz.MustNew("ID", "Asia/Makassar").OffsetAt(jul); have != 480 {
+		t.Errorf("have %d", have)
+	}
+
+	if _, err := tzembed.Loader().Load("Nowhere/Nothing"); err == nil {
+		t.Error("err is nil")
+	}
+}
@@ -0,0 +1,525 @@
+package tz
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// VTimezone gets the iCalendar (RFC 5545) VTIMEZONE component for this zone,
+// with all transitions between from and to. See WriteVTimezone().
+func (t *Zone) VTimezone(from, to time.Time) string {
+	b := new(strings.Builder)
+	_ = t.WriteVTimezone(b, from, to) // strings.Builder never errors.
+	return b.String()
+}
+
+// WriteVTimezone writes the iCalendar (RFC 5545) VTIMEZONE component for this
+// zone to w, with all transitions between from and to. The observance in effect
+// at from is included.
+//
+// Transitions that happen at the same wall clock time on the same weekday or day
+// of the month in consecutive years are written as a yearly RRULE; the last rule
+// has no UNTIL if the zone keeps changing by the same rule after to. Other
+// transitions are written as RDATE.
+//
+// The TZID is the zone name, such as "Europe/Amsterdam".
+func (t *Zone) WriteVTimezone(w io.Writer, from, to time.Time) error {
+	if tr, ok := t.PrevTransition(from.Add(1)); ok {
+		from = tr.At
+	}
+	var (
+		trs    = t.Transitions(from, to)
+		future = t.Transitions(to, to.AddDate(lookahead, 0, 0))
+		b      = new(strings.Builder)
+		line   = func(k, v string) { b.WriteString(k + ":" + v + "\r\n") }
+		tzid   = "UTC"
+	)
+	if t != nil {
+		tzid = t.Zone
+	}
+
+	line("BEGIN", "VTIMEZONE")
+	line("TZID", tzid)
+	if len(trs) == 0 {
+		name, off := from.In(t.Loc()).Zone()
+		o := fmtOffset(time.Duration(off) * time.Second)
+		line("BEGIN", "STANDARD")
+		line("DTSTART", "19700101T000000")
+		line("TZOFFSETFROM", o)
+		line("TZOFFSETTO", o)
+		line("TZNAME", name)
+		line("END", "STANDARD")
+	}
+	for _, o := range observances(trs, future) {
+		typ := "STANDARD"
+		if o.DST {
+			typ = "DAYLIGHT"
+		}
+		line("BEGIN", typ)
+		line("DTSTART", o.At[0].Add(o.OffsetBefore).Format(icalTime))
+		line("TZOFFSETFROM", fmtOffset(o.OffsetBefore))
+		line("TZOFFSETTO", fmtOffset(o.OffsetAfter))
+		line("TZNAME", o.AbbrAfter)
+		if o.rule != nil {
+			line("RRULE", o.rule.String())
+		} else {
+			for _, at := range o.At[1:] {
+				line("RDATE", at.Add(o.OffsetBefore).Format(icalTime))
+			}
+		}
+		line("END", typ)
+	}
+	line("END", "VTIMEZONE")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+const icalTime = "20060102T150405"
+
+// lookahead is how many years of transitions after the end are used to check if
+// a rule continues. This is enough to see every weekday on every day of the
+// month.
+const lookahead = 28
+
+// observance is a set of transitions with the same offsets and abbreviation.
+type observance struct {
+	Transition
+	At   []time.Time // All onsets, in UTC.
+	rule *Recurrence // Yearly rule for At, if any.
+}
+
+// observances groups the transitions in observances, using a yearly rule where
+// possible. The last rule for an observance has no UNTIL if it also matches all
+// the future transitions.
+func observances(trs, future []Transition) []observance {
+	type key struct {
+		from, to time.Duration
+		name     string
+		dst      bool
+	}
+	var (
+		order  []key
+		groups = make(map[key][]Transition)
+		next   = make(map[key][]Transition)
+	)
+	for _, tr := range trs {
+		k := key{tr.OffsetBefore, tr.OffsetAfter, tr.AbbrAfter, tr.DST}
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], tr)
+	}
+	for _, tr := range future {
+		k := key{tr.OffsetBefore, tr.OffsetAfter, tr.AbbrAfter, tr.DST}
+		next[k] = append(next[k], tr)
+	}
+
+	var r []observance
+	for _, k := range order {
+		var (
+			g    = groups[k]
+			rest []time.Time
+		)
+		for i := 0; i < len(g); {
+			// Include the future transitions so that the rule is the one that
+			// continues, rather than one that happens to match the first years.
+			rule, n := yearlyRule(slices.Concat(g[i:], next[k]))
+			open := len(next[k]) > 0 && n == len(g)-i+len(next[k])
+			n = min(n, len(g)-i)
+			if n < 2 && !open {
+				rest = append(rest, g[i].At)
+				i++
+				continue
+			}
+			if !open {
+				rule.Until = g[i+n-1].At
+			}
+			r = append(r, observance{Transition: g[i], At: []time.Time{g[i].At}, rule: rule})
+			i += n
+		}
+		if len(rest) > 0 {
+			r = append(r, observance{Transition: g[0], At: rest})
+		}
+	}
+	slices.SortStableFunc(r, func(a, b observance) int { return a.At[0].Compare(b.At[0]) })
+	return r
+}
+
+// yearlyRule gets the longest yearly rule for the transitions, starting from the
+// first, and the number of transitions it covers.
+func yearlyRule(trs []Transition) (*Recurrence, int) {
+	var (
+		first                = trs[0].At.Add(trs[0].OffsetBefore)
+		nth, last            = ordinal(first)
+		nthOK, lastOK, dayOK = true, last, true
+		lo, hi               = first.Day(), first.Day()
+		n                    = 1
+	)
+	for ; n < len(trs); n++ {
+		at := trs[n].At.Add(trs[n].OffsetBefore)
+		if at.Year() != first.Year()+n || at.Month() != first.Month() || sinceMidnight(at) != sinceMidnight(first) {
+			break
+		}
+		atNth, atLast := ordinal(at)
+		sameDay := at.Weekday() == first.Weekday()
+		nn, ll, dd := nthOK && sameDay && atNth == nth, lastOK && sameDay && atLast, dayOK && at.Day() == first.Day()
+		// Weekday on or after a day of the month, such as "Sun>=2" in tzdata.
+		rr := sameDay && max(hi, at.Day())-min(lo, at.Day()) < 7
+		if !nn && !ll && !dd && !rr {
+			break
+		}
+		nthOK, lastOK, dayOK = nn, ll, dd
+		lo, hi = min(lo, at.Day()), max(hi, at.Day())
+	}
+
+	rule := &Recurrence{Freq: Yearly, ByMonth: []time.Month{first.Month()}}
+	switch {
+	case lastOK && (!nthOK || nth >= 4):
+		rule.ByDay = []RecurDay{{N: -1, Weekday: first.Weekday()}}
+	case nthOK:
+		rule.ByDay = []RecurDay{{N: nth, Weekday: first.Weekday()}}
+	case dayOK:
+		rule.ByMonthDay = []int{first.Day()}
+	default:
+		rule.ByDay = []RecurDay{{Weekday: first.Weekday()}}
+		for d := lo; d < lo+7 && d <= 31; d++ {
+			rule.ByMonthDay = append(rule.ByMonthDay, d)
+		}
+	}
+	return rule, n
+}
+
+// ordinal gets the ordinal of the weekday of t in the month, and if it's the
+// last one.
+func ordinal(t time.Time) (int, bool) {
+	days := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return (t.Day()-1)/7 + 1, t.Day()+7 > days
+}
+
+// fmtOffset formats the offset as "+0100", or "+003412" if there are seconds.
+func fmtOffset(d time.Duration) string {
+	s := int(d / time.Second)
+	sign := "+"
+	if s < 0 {
+		sign, s = "-", -s
+	}
+	if s%60 != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, s/3600, s/60%60)
+}
+
+// ParseVTimezone reads the first VTIMEZONE component from r, which may be a
+// full iCalendar file, and gets the zone for it.
+//
+// The TZID is used if it's a known zone name, alias, or Windows timezone ID
+// (Outlook uses "W. Europe Standard Time"), or ends with one as in
+// "/example.com/20240101_1/Europe/Amsterdam".
+//
+// Otherwise the zone with the same offsets is used. The offsets must be the same
+// in the year up to the last onset, or in the next year if all observances are
+// a RRULE without end (Outlook uses rules that start in 1601). After the last
+// onset the VTIMEZONE may be outdated, so the zone with the fewest differences
+// up to now is used. If more than one zone matches, the zone that also uses the
+// same abbreviations is used, and then the zones listed in PreferredZones.
+func ParseVTimezone(r io.Reader) (*Zone, error) {
+	vtz, err := readVTimezone(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for tzid := vtz.tzid; tzid != ""; {
+		if z, err := New("", tzid); err == nil {
+			return z, nil
+		}
+		_, tzid, _ = strings.Cut(tzid, "/")
+	}
+
+	if z := vtz.match(); z != nil {
+		return z, nil
+	}
+	return nil, &LookupError{Zone: vtz.tzid, Err: ErrUnknownZone}
+}
+
+type (
+	vtimezone struct {
+		tzid string
+		obs  []vobservance
+	}
+	vobservance struct {
+		start    time.Time // Local time in TZOFFSETFROM, as UTC.
+		from, to int
+		name     string
+		rrule    string
+		rdate    []time.Time // Local time in TZOFFSETFROM, as UTC.
+	}
+)
+
+// onset is when an observance starts.
+type onset struct {
+	at  time.Time
+	obs *vobservance
+}
+
+// match the VTIMEZONE to a zone by comparing the offsets and abbreviations.
+func (vtz vtimezone) match() *Zone {
+	// Get all onsets, and the last onset that's not from a rule without end.
+	var (
+		now    = time.Now()
+		last   time.Time
+		open   = true
+		onsets []onset
+		rules  = make([]*Recurrence, len(vtz.obs))
+	)
+	for i := range vtz.obs {
+		o := &vtz.obs[i]
+		utc := func(t time.Time) time.Time { return t.Add(-time.Duration(o.from) * time.Second) }
+		onsets = append(onsets, onset{utc(o.start), o})
+		for _, d := range o.rdate {
+			onsets = append(onsets, onset{utc(d), o})
+		}
+
+		if o.rrule != "" {
+			fixed := &Zone{Location: time.FixedZone("", o.from)}
+			rule, err := ParseRecurrence(fixed, utc(o.start), o.rrule)
+			if err == nil && rule.Until.IsZero() && rule.Count == 0 {
+				rules[i] = rule
+				if len(o.rdate) == 0 {
+					continue
+				}
+			} else if err == nil {
+				for _, t := range rule.Between(rule.Start, time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)) {
+					onsets = append(onsets, onset{t.UTC(), o})
+				}
+			}
+		}
+		open = false
+	}
+	for _, o := range onsets {
+		if o.at.After(last) {
+			last = o.at
+		}
+	}
+	if open {
+		last = now.AddDate(1, 0, 0)
+	}
+	end := last
+	if now.After(end) {
+		end = now
+	}
+	for i, r := range rules {
+		if r != nil {
+			for _, t := range r.Between(r.Start, end.Add(1)) {
+				onsets = append(onsets, onset{t.UTC(), &vtz.obs[i]})
+			}
+		}
+	}
+	slices.SortStableFunc(onsets, func(a, b onset) int { return a.at.Compare(b.at) })
+
+	type sample struct {
+		t    time.Time
+		off  int
+		name string
+	}
+	var (
+		start    = last.AddDate(-1, 0, 0)
+		required []sample
+		add      = func(list []sample, t time.Time) []sample {
+			i := sort.Search(len(onsets), func(i int) bool { return onsets[i].at.After(t) })
+			if i == 0 {
+				return list
+			}
+			return append(list, sample{t, onsets[i-1].obs.to, onsets[i-1].obs.name})
+		}
+	)
+	// The year up to the last onset must match: compare at noon every day, and
+	// around every onset.
+	for t := start.Add(12 * time.Hour); t.Before(last); t = t.AddDate(0, 0, 1) {
+		required = add(required, t)
+	}
+	for _, o := range onsets {
+		if !o.at.Before(start) && !o.at.After(last) {
+			required = add(add(required, o.at.Add(-time.Second)), o.at)
+		}
+	}
+	// Nothing is known before the first onset, except that the offset right
+	// before it is the TZOFFSETFROM. This is only checked if it's in the
+	// required year, as DTSTART is often far in the past (16010101).
+	if f := onsets[0]; !f.at.Before(start) {
+		required = append(required, sample{f.at.Add(-time.Second), f.obs.from, ""})
+	}
+	if len(required) == 0 {
+		return nil
+	}
+	var after []sample
+	for _, o := range onsets {
+		if o.at.After(last) {
+			after = add(add(after, o.at.Add(-time.Second)), o.at)
+		}
+	}
+
+	loadLocations()
+	var (
+		best                *Zone
+		bestMiss, bestScore = 0, -1
+		bestPref            bool
+		preferred           = make(map[string]bool)
+		seen                = make(map[string]struct{})
+	)
+	for _, p := range PreferredZones {
+		preferred[p] = true
+	}
+	for _, z := range append(ByAbbr(required[0].name), Zones...) {
+		if _, ok := seen[z.Zone]; ok || !z.Loaded() {
+			continue
+		}
+		seen[z.Zone] = struct{}{}
+
+		score := 0
+		for _, s := range required {
+			name, off := s.t.In(z.Location).Zone()
+			if off != s.off {
+				score = -1
+				break
+			}
+			if name == s.name {
+				score++
+			}
+		}
+		if score == -1 {
+			continue
+		}
+
+		// The VTIMEZONE may be out of date after the last onset, so use the
+		// number of differences after that up to now to rank the zones, rather
+		// than requiring an exact match. This picks Australia/Darwin over
+		// Australia/Adelaide for a VTIMEZONE with only ACST since 1944.
+		later := slices.Clone(after)
+		for _, tr := range z.Transitions(last.Add(1), end) {
+			later = add(add(later, tr.At.Add(-time.Second)), tr.At)
+		}
+		miss := 0
+		for _, s := range later {
+			if _, off := s.t.In(z.Location).Zone(); off != s.off {
+				miss++
+			}
+		}
+
+		pref := preferred[z.Zone]
+		if best == nil || miss < bestMiss ||
+			miss == bestMiss && (score > bestScore || score == bestScore && pref && !bestPref) {
+			best, bestMiss, bestScore, bestPref = z, miss, score, pref
+		}
+	}
+	return best
+}
+
+func readVTimezone(r io.Reader) (vtimezone, error) {
+	var (
+		vtz   vtimezone
+		lines []string
+		err   error
+		scan  = bufio.NewScanner(r)
+		errf  = func(f string, a ...any) (vtimezone, error) {
+			return vtimezone{}, fmt.Errorf("tz.ParseVTimezone: %w: "+f, append([]any{ErrInvalidFormat}, a...)...)
+		}
+	)
+	for scan.Scan() {
+		l := strings.TrimRight(scan.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) {
+			lines[len(lines)-1] += l[1:] // Folded line.
+			continue
+		}
+		lines = append(lines, l)
+	}
+	if err := scan.Err(); err != nil {
+		return vtimezone{}, err
+	}
+
+	var (
+		in  bool
+		obs *vobservance
+	)
+	for _, l := range lines {
+		k, v, ok := strings.Cut(l, ":")
+		if !ok {
+			continue
+		}
+		k, params, _ := strings.Cut(strings.ToUpper(k), ";")
+
+		switch {
+		case k == "BEGIN" && strings.EqualFold(v, "VTIMEZONE"):
+			in = true
+		case !in:
+		case k == "END" && strings.EqualFold(v, "VTIMEZONE"):
+			if len(vtz.obs) == 0 {
+				return errf("no STANDARD or DAYLIGHT in VTIMEZONE %q", vtz.tzid)
+			}
+			return vtz, nil
+		case k == "TZID" && obs == nil:
+			vtz.tzid = strings.Trim(v, `"`)
+		case k == "BEGIN" && (strings.EqualFold(v, "STANDARD") || strings.EqualFold(v, "DAYLIGHT")):
+			obs = new(vobservance)
+		case obs == nil:
+		case k == "END":
+			if obs.start.IsZero() {
+				return errf("no DTSTART in %s", v)
+			}
+			vtz.obs, obs = append(vtz.obs, *obs), nil
+		case k == "DTSTART":
+			obs.start, err = time.Parse(icalTime, v)
+		case k == "TZOFFSETFROM":
+			obs.from, err = parseOffset(v)
+		case k == "TZOFFSETTO":
+			obs.to, err = parseOffset(v)
+		case k == "TZNAME":
+			obs.name = v
+		case k == "RRULE":
+			obs.rrule = v
+		case k == "RDATE" && !strings.Contains(params, "PERIOD"):
+			for _, d := range strings.Split(v, ",") {
+				var t time.Time
+				t, err = time.Parse(icalTime, strings.TrimSuffix(d, "Z"))
+				if err != nil {
+					break
+				}
+				if strings.HasSuffix(d, "Z") {
+					t = t.Add(time.Duration(obs.from) * time.Second) // Store as local time.
+				}
+				obs.rdate = append(obs.rdate, t)
+			}
+		}
+		if err != nil {
+			return errf("%s: %s", l, err)
+		}
+	}
+	if in {
+		return errf("no END:VTIMEZONE")
+	}
+	return errf("no VTIMEZONE")
+}
+
+func parseOffset(s string) (int, error) {
+	if len(s) != 5 && len(s) != 7 || (s[0] != '+' && s[0] != '-') {
+		return 0, fmt.Errorf("invalid offset %q", s)
+	}
+	var h, m, sec int
+	_, err := fmt.Sscanf(s[1:5], "%02d%02d", &h, &m)
+	if err == nil && len(s) == 7 {
+		_, err = fmt.Sscanf(s[5:], "%02d", &sec)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid offset %q", s)
+	}
+	o := h*3600 + m*60 + sec
+	if s[0] == '-' {
+		o = -o
+	}
+	return o, nil
+}
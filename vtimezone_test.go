@@ -0,0 +1,256 @@
+package tz
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVTimezone(t *testing.T) {
+	var (
+		from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	tests := []struct {
+		zone     *Zone
+		from, to time.Time
+		want     string
+	}{
+		{MustNew("", "Europe/Brussels"), from, to, `
+			BEGIN:VTIMEZONE
+			TZID:Europe/Brussels
+			BEGIN:STANDARD
+			DTSTART:20231029T030000
+			TZOFFSETFROM:+0200
+			TZOFFSETTO:+0100
+			TZNAME:CET
+			RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10
+			END:STANDARD
+			BEGIN:DAYLIGHT
+			DTSTART:20240331T020000
+			TZOFFSETFROM:+0100
+			TZOFFSETTO:+0200
+			TZNAME:CEST
+			RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3
+			END:DAYLIGHT
+			END:VTIMEZONE`},
+
+		// DST was abolished in 2019.
+		{MustNew("", "America/Sao_Paulo"), time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC), to, `
+			BEGIN:VTIMEZONE
+			TZID:America/Sao_Paulo
+			BEGIN:DAYLIGHT
+			DTSTART:20151018T000000
+			TZOFFSETFROM:-0300
+			TZOFFSETTO:-0200
+			TZNAME:-02
+			RRULE:FREQ=YEARLY;BYDAY=3SU;BYMONTH=10;UNTIL=20171015T030000Z
+			END:DAYLIGHT
+			BEGIN:STANDARD
+			DTSTART:20160221T000000
+			TZOFFSETFROM:-0200
+			TZOFFSETTO:-0300
+			TZNAME:-03
+			RRULE:FREQ=YEARLY;BYDAY=3SU;BYMONTH=2;UNTIL=20190217T020000Z
+			END:STANDARD
+			BEGIN:DAYLIGHT
+			DTSTART:20181104T000000
+			TZOFFSETFROM:-0300
+			TZOFFSETTO:-0200
+			TZNAME:-02
+			END:DAYLIGHT
+			END:VTIMEZONE`},
+
+		// Sunday on or after the 2nd.
+		{MustNew("", "America/Santiago"), from, to, `
+			BEGIN:VTIMEZONE
+			TZID:America/Santiago
+			BEGIN:DAYLIGHT
+			DTSTART:20230903T000000
+			TZOFFSETFROM:-0400
+			TZOFFSETTO:-0300
+			TZNAME:-03
+			RRULE:FREQ=YEARLY;BYDAY=SU;BYMONTHDAY=2,3,4,5,6,7,8;BYMONTH=9
+			END:DAYLIGHT
+			BEGIN:STANDARD
+			DTSTART:20240407T000000
+			TZOFFSETFROM:-0300
+			TZOFFSETTO:-0400
+			TZNAME:-04
+			RRULE:FREQ=YEARLY;BYDAY=SU;BYMONTHDAY=2,3,4,5,6,7,8;BYMONTH=4
+			END:STANDARD
+			END:VTIMEZONE`},
+
+		// Single change.
+		{MustNew("", "Asia/Makassar"), from, to, `
+			BEGIN:VTIMEZONE
+			TZID:Asia/Makassar
+			BEGIN:STANDARD
+			DTSTART:19450923T000000
+			TZOFFSETFROM:+0900
+			TZOFFSETTO:+0800
+			TZNAME:WITA
+			END:STANDARD
+			END:VTIMEZONE`},
+
+		// Fixed dates; last rule has UNTIL as the changes stop after 2022.
+		{MustNew("", "Asia/Tehran"), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), to, `
+			BEGIN:VTIMEZONE
+			TZID:Asia/Tehran
+			BEGIN:STANDARD
+			DTSTART:20200921T000000
+			TZOFFSETFROM:+0430
+			TZOFFSETTO:+0330
+			TZNAME:+0330
+			END:STANDARD
+			BEGIN:DAYLIGHT
+			DTSTART:20210322T000000
+			TZOFFSETFROM:+0330
+			TZOFFSETTO:+0430
+			TZNAME:+0430
+			RRULE:FREQ=YEARLY;BYMONTHDAY=22;BYMONTH=3;UNTIL=20220321T203000Z
+			END:DAYLIGHT
+			BEGIN:STANDARD
+			DTSTART:20210922T000000
+			TZOFFSETFROM:+0430
+			TZOFFSETTO:+0330
+			TZNAME:+0330
+			RRULE:FREQ=YEARLY;BYMONTHDAY=22;BYMONTH=9;UNTIL=20220921T193000Z
+			END:STANDARD
+			END:VTIMEZONE`},
+
+		{UTC, from, to, `
+			BEGIN:VTIMEZONE
+			TZID:UTC
+			BEGIN:STANDARD
+			DTSTART:19700101T000000
+			TZOFFSETFROM:+0000
+			TZOFFSETTO:+0000
+			TZNAME:UTC
+			END:STANDARD
+			END:VTIMEZONE`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone.Zone, func(t *testing.T) {
+			have := tt.zone.VTimezone(tt.from, tt.to)
+			want := strings.ReplaceAll(strings.TrimSpace(strings.ReplaceAll(tt.want, "\t", "")), "\n", "\r\n") + "\r\n"
+			if have != want {
+				t.Errorf("\nhave:\n%s\nwant:\n%s", have, want)
+			}
+		})
+	}
+}
+
+func TestParseVTimezone(t *testing.T) {
+	vtz := func(tzid, body string) string {
+		return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VTIMEZONE\r\nTZID:" + tzid + "\r\n" +
+			strings.ReplaceAll(strings.TrimSpace(strings.ReplaceAll(body, "\t", "")), "\n", "\r\n") +
+			"\r\nEND:VTIMEZONE\r\nEND:VCALENDAR\r\n"
+	}
+	fixed := `
+		BEGIN:STANDARD
+		DTSTART:19700101T000000
+		TZOFFSETFROM:+0800
+		TZOFFSETTO:+0800
+		END:STANDARD`
+	outlook := `
+		BEGIN:STANDARD
+		DTSTART:16011104T020000
+		RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11
+		TZOFFSETFROM:-0400
+		TZOFFSETTO:-0500
+		TZNAME:EST
+		END:STANDARD
+		BEGIN:DAYLIGHT
+		DTSTART:16010311T020000
+		RRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3
+		TZOFFSETFROM:-0500
+		TZOFFSETTO:-0400
+		TZNAME:EDT
+		END:DAYLIGHT`
+
+	tests := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{vtz("Europe/Brussels", fixed), "BE.Europe/Brussels", nil},
+		{vtz(`"Asia/Saigon"`, fixed), "VN.Asia/Ho_Chi_Minh", nil},
+		{vtz("/example.com/20240101_1/Asia/Makassar", fixed), "ID.Asia/Makassar", nil},
+		{vtz("W. Europe Standard Time", fixed), "DE.Europe/Berlin", nil},
+		{vtz("Eastern Standard Time", outlook), "US.America/New_York", nil},
+		{vtz("Custom", outlook), "US.America/New_York", nil},
+		{vtz("Custom", strings.ReplaceAll(strings.ReplaceAll(outlook, "EST", "XXX"), "EDT", "YYY")), "US.America/New_York", nil},
+		{vtz("Custom", strings.ReplaceAll(fixed, "END:STANDARD", "TZNAME:WITA\nEND:STANDARD")), "ID.Asia/Makassar", nil},
+		{strings.ReplaceAll(vtz("Europe/Brussels", fixed), "TZID:Europe/Brussels\r\n", "TZID:Europe/Bru\r\n ssels\r\n"), "BE.Europe/Brussels", nil},
+
+		{vtz("Custom", strings.ReplaceAll(fixed, "+0800", "+0017")), "", ErrUnknownZone},
+		{"BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", "", ErrInvalidFormat},
+		{vtz("Custom", ""), "", ErrInvalidFormat},
+		{vtz("Custom", strings.ReplaceAll(fixed, "+0800", "0800")), "", ErrInvalidFormat},
+		{vtz("Custom", strings.ReplaceAll(fixed, "19700101T000000", "1970")), "", ErrInvalidFormat},
+		{strings.TrimSuffix(vtz("Custom", fixed), "END:VTIMEZONE\r\nEND:VCALENDAR\r\n"), "", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			z, err := ParseVTimezone(strings.NewReader(tt.in))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrong error\nhave: %v\nwant: %v", err, tt.wantErr)
+			}
+			if have := z.String(); have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestVTimezoneRoundtrip(t *testing.T) {
+	var (
+		from = time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	tests := []struct {
+		zone     string
+		from, to time.Time
+	}{
+		{"Europe/Brussels", from, to},
+		{"America/New_York", from, to},
+		{"America/Sao_Paulo", from, to},
+		{"Australia/Lord_Howe", from, to},
+		{"Asia/Tehran", from, to},
+		{"Asia/Kolkata", from, to},
+		{"Pacific/Chatham", from, to},
+		{"America/Santiago", from, to},
+		{"Africa/Cairo", from, to},
+		{"Australia/Darwin", from, to},
+		{"America/Phoenix", from, to},
+		{"America/Panama", from, to},
+		{"America/Puerto_Rico", from, to},
+		// Only has the onset for the end of DST in 2019.
+		{"America/Sao_Paulo", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone+"/"+tt.from.Format("2006"), func(t *testing.T) {
+			zone, from, to := tt.zone, tt.from, tt.to
+			z := MustNew("", zone)
+			v := strings.Replace(z.VTimezone(from, to), "TZID:"+zone, "TZID:Custom", 1)
+
+			have, err := ParseVTimezone(strings.NewReader(v))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have.Zone != zone {
+				t.Errorf("have %s, want %s", have.Zone, zone)
+			}
+			for tt := from; tt.Before(to); tt = tt.Add(6 * time.Hour) {
+				if have.OffsetAt(tt) != z.OffsetAt(tt) {
+					t.Fatalf("%s: offset at %s: %d, want %d", have, tt, have.OffsetAt(tt), z.OffsetAt(tt))
+				}
+			}
+		})
+	}
+}
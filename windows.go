@@ -0,0 +1,476 @@
+package tz
+
+// windowsZones maps Windows timezone IDs to a zone by territory; "001" is the
+// default. Generated by gen.go from cldr/windowsZones.xml.
+var windowsZones = map[string]map[string]string{
+	"AUS Central Standard Time":       {"001": "Australia/Darwin", "AU": "Australia/Darwin"},
+	"AUS Eastern Standard Time":       {"001": "Australia/Sydney", "AU": "Australia/Sydney"},
+	"Afghanistan Standard Time":       {"001": "Asia/Kabul", "AF": "Asia/Kabul"},
+	"Alaskan Standard Time":           {"001": "America/Anchorage", "US": "America/Anchorage"},
+	"Aleutian Standard Time":          {"001": "America/Adak", "US": "America/Adak"},
+	"Altai Standard Time":             {"001": "Asia/Barnaul", "RU": "Asia/Barnaul"},
+	"Arab Standard Time":              {"001": "Asia/Riyadh", "BH": "Asia/Qatar", "KW": "Asia/Riyadh", "QA": "Asia/Qatar", "SA": "Asia/Riyadh", "YE": "Asia/Riyadh"},
+	"Arabian Standard Time":           {"001": "Asia/Dubai", "AE": "Asia/Dubai", "OM": "Asia/Dubai", "ZZ": "Etc/GMT-4"},
+	"Arabic Standard Time":            {"001": "Asia/Baghdad", "IQ": "Asia/Baghdad"},
+	"Argentina Standard Time":         {"001": "America/Argentina/Buenos_Aires", "AR": "America/Argentina/Buenos_Aires"},
+	"Astrakhan Standard Time":         {"001": "Europe/Astrakhan", "RU": "Europe/Astrakhan"},
+	"Atlantic Standard Time":          {"001": "America/Halifax", "BM": "Atlantic/Bermuda", "CA": "America/Halifax", "GL": "America/Thule"},
+	"Aus Central W. Standard Time":    {"001": "Australia/Eucla", "AU": "Australia/Eucla"},
+	"Azerbaijan Standard Time":        {"001": "Asia/Baku", "AZ": "Asia/Baku"},
+	"Azores Standard Time":            {"001": "Atlantic/Azores", "GL": "America/Scoresbysund", "PT": "Atlantic/Azores"},
+	"Bahia Standard Time":             {"001": "America/Bahia", "BR": "America/Bahia"},
+	"Bangladesh Standard Time":        {"001": "Asia/Dhaka", "BD": "Asia/Dhaka", "BT": "Asia/Thimphu"},
+	"Belarus Standard Time":           {"001": "Europe/Minsk", "BY": "Europe/Minsk"},
+	"Bougainville Standard Time":      {"001": "Pacific/Bougainville", "PG": "Pacific/Bougainville"},
+	"Canada Central Standard Time":    {"001": "America/Regina", "CA": "America/Regina"},
+	"Cape Verde Standard Time":        {"001": "Atlantic/Cape_Verde", "CV": "Atlantic/Cape_Verde", "ZZ": "Etc/GMT+1"},
+	"Caucasus Standard Time":          {"001": "Asia/Yerevan", "AM": "Asia/Yerevan"},
+	"Cen. Australia Standard Time":    {"001": "Australia/Adelaide", "AU": "Australia/Adelaide"},
+	"Central America Standard Time":   {"001": "America/Guatemala", "BZ": "America/Belize", "CR": "America/Costa_Rica", "EC": "Pacific/Galapagos", "GT": "America/Guatemala", "HN": "America/Tegucigalpa", "NI": "America/Managua", "SV": "America/El_Salvador", "ZZ": "Etc/GMT+6"},
+	"Central Asia Standard Time":      {"001": "Asia/Bishkek", "AQ": "Antarctica/Vostok", "CN": "Asia/Urumqi", "IO": "Indian/Chagos", "KG": "Asia/Bishkek", "ZZ": "Etc/GMT-6"},
+	"Central Brazilian Standard Time": {"001": "America/Cuiaba", "BR": "America/Cuiaba"},
+	"Central Europe Standard Time":    {"001": "Europe/Budapest", "AL": "Europe/Tirane", "CZ": "Europe/Prague", "HU": "Europe/Budapest", "ME": "Europe/Belgrade", "RS": "Europe/Belgrade", "SI": "Europe/Belgrade", "SK": "Europe/Prague"},
+	"Central European Standard Time":  {"001": "Europe/Warsaw", "BA": "Europe/Belgrade", "HR": "Europe/Belgrade", "MK": "Europe/Belgrade", "PL": "Europe/Warsaw"},
+	"Central Pacific Standard Time":   {"001": "Pacific/Guadalcanal", "AQ": "Antarctica/Casey", "FM": "Pacific/Guadalcanal", "NC": "Pacific/Noumea", "SB": "Pacific/Guadalcanal", "VU": "Pacific/Efate", "ZZ": "Etc/GMT-11"},
+	"Central Standard Time":           {"001": "America/Chicago", "CA": "America/Winnipeg", "MX": "America/Matamoros", "US": "America/Chicago"},
+	"Central Standard Time (Mexico)":  {"001": "America/Mexico_City", "MX": "America/Mexico_City"},
+	"Chatham Islands Standard Time":   {"001": "Pacific/Chatham", "NZ": "Pacific/Chatham"},
+	"China Standard Time":             {"001": "Asia/Shanghai", "CN": "Asia/Shanghai", "HK": "Asia/Hong_Kong", "MO": "Asia/Macau"},
+	"Cuba Standard Time":              {"001": "America/Havana", "CU": "America/Havana"},
+	"Dateline Standard Time":          {"001": "Etc/GMT+12", "ZZ": "Etc/GMT+12"},
+	"E. Africa Standard Time":         {"001": "Africa/Nairobi", "AQ": "Asia/Riyadh", "DJ": "Africa/Nairobi", "ER": "Africa/Nairobi", "ET": "Africa/Nairobi", "KE": "Africa/Nairobi", "KM": "Africa/Nairobi", "MG": "Africa/Nairobi", "SO": "Africa/Nairobi", "TZ": "Africa/Nairobi", "UG": "Africa/Nairobi", "YT": "Africa/Nairobi", "ZZ": "Etc/GMT-3"},
+	"E. Australia Standard Time":      {"001": "Australia/Brisbane", "AU": "Australia/Brisbane"},
+	"E. Europe Standard Time":         {"001": "Europe/Chisinau", "MD": "Europe/Chisinau"},
+	"E. South America Standard Time":  {"001": "America/Sao_Paulo", "BR": "America/Sao_Paulo"},
+	"Easter Island Standard Time":     {"001": "Pacific/Easter", "CL": "Pacific/Easter"},
+	"Eastern Standard Time":           {"001": "America/New_York", "BS": "America/Toronto", "CA": "America/Toronto", "US": "America/New_York"},
+	"Eastern Standard Time (Mexico)":  {"001": "America/Cancun", "MX": "America/Cancun"},
+	"Egypt Standard Time":             {"001": "Africa/Cairo", "EG": "Africa/Cairo"},
+	"Ekaterinburg Standard Time":      {"001": "Asia/Yekaterinburg", "RU": "Asia/Yekaterinburg"},
+	"FLE Standard Time":               {"001": "Europe/Kyiv", "AX": "Europe/Helsinki", "BG": "Europe/Sofia", "EE": "Europe/Tallinn", "FI": "Europe/Helsinki", "LT": "Europe/Vilnius", "LV": "Europe/Riga", "UA": "Europe/Kyiv"},
+	"Fiji Standard Time":              {"001": "Pacific/Fiji", "FJ": "Pacific/Fiji"},
+	"GMT Standard Time":               {"001": "Europe/London", "ES": "Atlantic/Canary", "FO": "Atlantic/Faroe", "GB": "Europe/London", "GG": "Europe/London", "IE": "Europe/Dublin", "IM": "Europe/London", "JE": "Europe/London", "PT": "Europe/Lisbon"},
+	"GTB Standard Time":               {"001": "Europe/Bucharest", "CY": "Asia/Nicosia", "GR": "Europe/Athens", "RO": "Europe/Bucharest"},
+	"Georgian Standard Time":          {"001": "Asia/Tbilisi", "GE": "Asia/Tbilisi"},
+	"Greenland Standard Time":         {"001": "America/Nuuk", "GL": "America/Nuuk"},
+	"Greenwich Standard Time":         {"001": "Africa/Abidjan", "BF": "Africa/Abidjan", "CI": "Africa/Abidjan", "GH": "Africa/Abidjan", "GL": "America/Danmarkshavn", "GM": "Africa/Abidjan", "GN": "Africa/Abidjan", "GW": "Africa/Bissau", "IS": "Africa/Abidjan", "LR": "Africa/Monrovia", "ML": "Africa/Abidjan", "MR": "Africa/Abidjan", "SH": "Africa/Abidjan", "SL": "Africa/Abidjan", "SN": "Africa/Abidjan", "TG": "Africa/Abidjan"},
+	"Haiti Standard Time":             {"001": "America/Port-au-Prince", "HT": "America/Port-au-Prince"},
+	"Hawaiian Standard Time":          {"001": "Pacific/Honolulu", "CK": "Pacific/Rarotonga", "PF": "Pacific/Tahiti", "US": "Pacific/Honolulu", "ZZ": "Etc/GMT+10"},
+	"India Standard Time":             {"001": "Asia/Kolkata", "IN": "Asia/Kolkata"},
+	"Iran Standard Time":              {"001": "Asia/Tehran", "IR": "Asia/Tehran"},
+	"Israel Standard Time":            {"001": "Asia/Jerusalem", "IL": "Asia/Jerusalem"},
+	"Jordan Standard Time":            {"001": "Asia/Amman", "JO": "Asia/Amman"},
+	"Kaliningrad Standard Time":       {"001": "Europe/Kaliningrad", "RU": "Europe/Kaliningrad"},
+	"Korea Standard Time":             {"001": "Asia/Seoul", "KR": "Asia/Seoul"},
+	"Libya Standard Time":             {"001": "Africa/Tripoli", "LY": "Africa/Tripoli"},
+	"Line Islands Standard Time":      {"001": "Pacific/Kiritimati", "KI": "Pacific/Kiritimati", "ZZ": "Etc/GMT-14"},
+	"Lord Howe Standard Time":         {"001": "Australia/Lord_Howe", "AU": "Australia/Lord_Howe"},
+	"Magadan Standard Time":           {"001": "Asia/Magadan", "RU": "Asia/Magadan"},
+	"Magallanes Standard Time":        {"001": "America/Punta_Arenas", "CL": "America/Punta_Arenas"},
+	"Marquesas Standard Time":         {"001": "Pacific/Marquesas", "PF": "Pacific/Marquesas"},
+	"Mauritius Standard Time":         {"001": "Indian/Mauritius", "MU": "Indian/Mauritius", "RE": "Asia/Dubai", "SC": "Asia/Dubai"},
+	"Middle East Standard Time":       {"001": "Asia/Beirut", "LB": "Asia/Beirut"},
+	"Montevideo Standard Time":        {"001": "America/Montevideo", "UY": "America/Montevideo"},
+	"Morocco Standard Time":           {"001": "Africa/Casablanca", "EH": "Africa/El_Aaiun", "MA": "Africa/Casablanca"},
+	"Mountain Standard Time":          {"001": "America/Denver", "CA": "America/Edmonton", "MX": "America/Ciudad_Juarez", "US": "America/Denver"},
+	"Mountain Standard Time (Mexico)": {"001": "America/Mazatlan", "MX": "America/Mazatlan"},
+	"Myanmar Standard Time":           {"001": "Asia/Yangon", "CC": "Asia/Yangon", "MM": "Asia/Yangon"},
+	"N. Central Asia Standard Time":   {"001": "Asia/Novosibirsk", "RU": "Asia/Novosibirsk"},
+	"Namibia Standard Time":           {"001": "Africa/Windhoek", "NA": "Africa/Windhoek"},
+	"Nepal Standard Time":             {"001": "Asia/Kathmandu", "NP": "Asia/Kathmandu"},
+	"New Zealand Standard Time":       {"001": "Pacific/Auckland", "AQ": "Pacific/Auckland", "NZ": "Pacific/Auckland"},
+	"Newfoundland Standard Time":      {"001": "America/St_Johns", "CA": "America/St_Johns"},
+	"Norfolk Standard Time":           {"001": "Pacific/Norfolk", "NF": "Pacific/Norfolk"},
+	"North Asia East Standard Time":   {"001": "Asia/Irkutsk", "RU": "Asia/Irkutsk"},
+	"North Asia Standard Time":        {"001": "Asia/Krasnoyarsk", "RU": "Asia/Krasnoyarsk"},
+	"North Korea Standard Time":       {"001": "Asia/Pyongyang", "KP": "Asia/Pyongyang"},
+	"Omsk Standard Time":              {"001": "Asia/Omsk", "RU": "Asia/Omsk"},
+	"Pacific SA Standard Time":        {"001": "America/Santiago", "CL": "America/Santiago"},
+	"Pacific Standard Time":           {"001": "America/Los_Angeles", "CA": "America/Vancouver", "US": "America/Los_Angeles"},
+	"Pacific Standard Time (Mexico)":  {"001": "America/Tijuana", "MX": "America/Tijuana"},
+	"Pakistan Standard Time":          {"001": "Asia/Karachi", "PK": "Asia/Karachi"},
+	"Paraguay Standard Time":          {"001": "America/Asuncion", "PY": "America/Asuncion"},
+	"Qyzylorda Standard Time":         {"001": "Asia/Qyzylorda", "KZ": "Asia/Qyzylorda"},
+	"Romance Standard Time":           {"001": "Europe/Paris", "BE": "Europe/Brussels", "DK": "Europe/Berlin", "ES": "Europe/Madrid", "FR": "Europe/Paris"},
+	"Russia Time Zone 10":             {"001": "Asia/Srednekolymsk", "RU": "Asia/Srednekolymsk"},
+	"Russia Time Zone 11":             {"001": "Asia/Kamchatka", "RU": "Asia/Kamchatka"},
+	"Russia Time Zone 3":              {"001": "Europe/Samara", "RU": "Europe/Samara"},
+	"Russian Standard Time":           {"001": "Europe/Moscow", "RU": "Europe/Moscow", "UA": "Europe/Simferopol"},
+	"SA Eastern Standard Time":        {"001": "America/Cayenne", "AQ": "Antarctica/Rothera", "BR": "America/Fortaleza", "FK": "Atlantic/Stanley", "GF": "America/Cayenne", "SR": "America/Paramaribo", "ZZ": "Etc/GMT+3"},
+	"SA Pacific Standard Time":        {"001": "America/Bogota", "BR": "America/Rio_Branco", "CA": "America/Panama", "CO": "America/Bogota", "EC": "America/Guayaquil", "JM": "America/Jamaica", "KY": "America/Panama", "PA": "America/Panama", "PE": "America/Lima", "ZZ": "Etc/GMT+5"},
+	"SA Western Standard Time":        {"001": "America/La_Paz", "AG": "America/Puerto_Rico", "AI": "America/Puerto_Rico", "AW": "America/Puerto_Rico", "BB": "America/Barbados", "BL": "America/Puerto_Rico", "BO": "America/La_Paz", "BQ": "America/Puerto_Rico", "BR": "America/Manaus", "CA": "America/Puerto_Rico", "CW": "America/Puerto_Rico", "DM": "America/Puerto_Rico", "DO": "America/Santo_Domingo", "GD": "America/Puerto_Rico", "GP": "America/Puerto_Rico", "GY": "America/Guyana", "KN": "America/Puerto_Rico", "LC": "America/Puerto_Rico", "MF": "America/Puerto_Rico", "MQ": "America/Martinique", "MS": "America/Puerto_Rico", "PR": "America/Puerto_Rico", "SX": "America/Puerto_Rico", "TT": "America/Puerto_Rico", "VC": "America/Puerto_Rico", "VG": "America/Puerto_Rico", "VI": "America/Puerto_Rico", "ZZ": "Etc/GMT+4"},
+	"SE Asia Standard Time":           {"001": "Asia/Bangkok", "AQ": "Antarctica/Davis", "CX": "Asia/Bangkok", "ID": "Asia/Jakarta", "KH": "Asia/Bangkok", "LA": "Asia/Bangkok", "TH": "Asia/Bangkok", "VN": "Asia/Ho_Chi_Minh", "ZZ": "Etc/GMT-7"},
+	"Saint Pierre Standard Time":      {"001": "America/Miquelon", "PM": "America/Miquelon"},
+	"Sakhalin Standard Time":          {"001": "Asia/Sakhalin", "RU": "Asia/Sakhalin"},
+	"Samoa Standard Time":             {"001": "Pacific/Apia", "WS": "Pacific/Apia"},
+	"Sao Tome Standard Time":          {"001": "Africa/Sao_Tome", "ST": "Africa/Sao_Tome"},
+	"Saratov Standard Time":           {"001": "Europe/Saratov", "RU": "Europe/Saratov"},
+	"Singapore Standard Time":         {"001": "Asia/Singapore", "BN": "Asia/Kuching", "ID": "Asia/Makassar", "MY": "Asia/Singapore", "PH": "Asia/Manila", "SG": "Asia/Singapore", "ZZ": "Etc/GMT-8"},
+	"South Africa Standard Time":      {"001": "Africa/Johannesburg", "BI": "Africa/Maputo", "BW": "Africa/Maputo", "CD": "Africa/Maputo", "LS": "Africa/Johannesburg", "MW": "Africa/Maputo", "MZ": "Africa/Maputo", "RW": "Africa/Maputo", "SZ": "Africa/Johannesburg", "ZA": "Africa/Johannesburg", "ZM": "Africa/Maputo", "ZW": "Africa/Maputo", "ZZ": "Etc/GMT-2"},
+	"South Sudan Standard Time":       {"001": "Africa/Juba", "SS": "Africa/Juba"},
+	"Sri Lanka Standard Time":         {"001": "Asia/Colombo", "LK": "Asia/Colombo"},
+	"Sudan Standard Time":             {"001": "Africa/Khartoum", "SD": "Africa/Khartoum"},
+	"Syria Standard Time":             {"001": "Asia/Damascus", "SY": "Asia/Damascus"},
+	"Taipei Standard Time":            {"001": "Asia/Taipei", "TW": "Asia/Taipei"},
+	"Tasmania Standard Time":          {"001": "Australia/Hobart", "AU": "Australia/Hobart"},
+	"Tocantins Standard Time":         {"001": "America/Araguaina", "BR": "America/Araguaina"},
+	"Tokyo Standard Time":             {"001": "Asia/Tokyo", "ID": "Asia/Jayapura", "JP": "Asia/Tokyo", "PW": "Pacific/Palau", "TL": "Asia/Dili", "ZZ": "Etc/GMT-9"},
+	"Tomsk Standard Time":             {"001": "Asia/Tomsk", "RU": "Asia/Tomsk"},
+	"Tonga Standard Time":             {"001": "Pacific/Tongatapu", "TO": "Pacific/Tongatapu"},
+	"Transbaikal Standard Time":       {"001": "Asia/Chita", "RU": "Asia/Chita"},
+	"Turkey Standard Time":            {"001": "Europe/Istanbul", "TR": "Europe/Istanbul"},
+	"Turks And Caicos Standard Time":  {"001": "America/Grand_Turk", "TC": "America/Grand_Turk"},
+	"US Eastern Standard Time":        {"001": "America/Indiana/Indianapolis", "US": "America/Indiana/Indianapolis"},
+	"US Mountain Standard Time":       {"001": "America/Phoenix", "CA": "America/Phoenix", "MX": "America/Hermosillo", "US": "America/Phoenix", "ZZ": "Etc/GMT+7"},
+	"UTC":                             {"001": "UTC", "ZZ": "UTC"},
+	"UTC+12":                          {"001": "Etc/GMT-12", "KI": "Pacific/Tarawa", "MH": "Pacific/Tarawa", "NR": "Pacific/Nauru", "TV": "Pacific/Tarawa", "UM": "Pacific/Tarawa", "WF": "Pacific/Tarawa", "ZZ": "Etc/GMT-12"},
+	"UTC+13":                          {"001": "Etc/GMT-13", "KI": "Pacific/Kanton", "TK": "Pacific/Fakaofo", "ZZ": "Etc/GMT-13"},
+	"UTC-02":                          {"001": "Etc/GMT+2", "BR": "America/Noronha", "GS": "Atlantic/South_Georgia", "ZZ": "Etc/GMT+2"},
+	"UTC-08":                          {"001": "Etc/GMT+8", "PN": "Pacific/Pitcairn", "ZZ": "Etc/GMT+8"},
+	"UTC-09":                          {"001": "Etc/GMT+9", "PF": "Pacific/Gambier", "ZZ": "Etc/GMT+9"},
+	"UTC-11":                          {"001": "Etc/GMT+11", "AS": "Pacific/Pago_Pago", "NU": "Pacific/Niue", "UM": "Pacific/Pago_Pago", "ZZ": "Etc/GMT+11"},
+	"Ulaanbaatar Standard Time":       {"001": "Asia/Ulaanbaatar", "MN": "Asia/Ulaanbaatar"},
+	"Venezuela Standard Time":         {"001": "America/Caracas", "VE": "America/Caracas"},
+	"Vladivostok Standard Time":       {"001": "Asia/Vladivostok", "RU": "Asia/Vladivostok"},
+	"Volgograd Standard Time":         {"001": "Europe/Volgograd", "RU": "Europe/Volgograd"},
+	"W. Australia Standard Time":      {"001": "Australia/Perth", "AU": "Australia/Perth"},
+	"W. Central Africa Standard Time": {"001": "Africa/Lagos", "AO": "Africa/Lagos", "BJ": "Africa/Lagos", "CD": "Africa/Lagos", "CF": "Africa/Lagos", "CG": "Africa/Lagos", "CM": "Africa/Lagos", "DZ": "Africa/Algiers", "GA": "Africa/Lagos", "GQ": "Africa/Lagos", "NE": "Africa/Lagos", "NG": "Africa/Lagos", "TD": "Africa/Ndjamena", "TN": "Africa/Tunis", "ZZ": "Etc/GMT-1"},
+	"W. Europe Standard Time":         {"001": "Europe/Berlin", "AD": "Europe/Andorra", "AT": "Europe/Vienna", "CH": "Europe/Zurich", "DE": "Europe/Berlin", "GI": "Europe/Gibraltar", "IT": "Europe/Rome", "LI": "Europe/Zurich", "LU": "Europe/Brussels", "MC": "Europe/Paris", "MT": "Europe/Malta", "NL": "Europe/Brussels", "NO": "Europe/Berlin", "SE": "Europe/Berlin", "SJ": "Europe/Berlin", "SM": "Europe/Rome", "VA": "Europe/Rome"},
+	"W. Mongolia Standard Time":       {"001": "Asia/Hovd", "MN": "Asia/Hovd"},
+	"West Asia Standard Time":         {"001": "Asia/Tashkent", "AQ": "Antarctica/Mawson", "KZ": "Asia/Oral", "MV": "Indian/Maldives", "TF": "Indian/Maldives", "TJ": "Asia/Dushanbe", "TM": "Asia/Ashgabat", "UZ": "Asia/Tashkent", "ZZ": "Etc/GMT-5"},
+	"West Bank Standard Time":         {"001": "Asia/Hebron", "PS": "Asia/Hebron"},
+	"West Pacific Standard Time":      {"001": "Pacific/Port_Moresby", "AQ": "Pacific/Port_Moresby", "FM": "Pacific/Port_Moresby", "GU": "Pacific/Guam", "MP": "Pacific/Guam", "PG": "Pacific/Port_Moresby", "ZZ": "Etc/GMT-10"},
+	"Yakutsk Standard Time":           {"001": "Asia/Yakutsk", "RU": "Asia/Yakutsk"},
+	"Yukon Standard Time":             {"001": "America/Whitehorse", "CA": "America/Whitehorse"},
+}
+
+// territoryWindows maps zones to a Windows timezone ID by territory, if it's
+// different from zoneWindows.
+var territoryWindows = map[string]map[string]string{
+	"AQ": {"Asia/Riyadh": "E. Africa Standard Time"},
+	"BA": {"Europe/Belgrade": "Central European Standard Time"},
+	"DK": {"Europe/Berlin": "Romance Standard Time"},
+	"HR": {"Europe/Belgrade": "Central European Standard Time"},
+	"LU": {"Europe/Brussels": "W. Europe Standard Time"},
+	"MC": {"Europe/Paris": "W. Europe Standard Time"},
+	"MK": {"Europe/Belgrade": "Central European Standard Time"},
+	"NL": {"Europe/Brussels": "W. Europe Standard Time"},
+	"RE": {"Asia/Dubai": "Mauritius Standard Time"},
+	"SC": {"Asia/Dubai": "Mauritius Standard Time"},
+}
+
+// zoneWindows maps zones to a Windows timezone ID.
+var zoneWindows = map[string]string{
+	"Africa/Abidjan":                 "Greenwich Standard Time",
+	"Africa/Algiers":                 "W. Central Africa Standard Time",
+	"Africa/Bissau":                  "Greenwich Standard Time",
+	"Africa/Cairo":                   "Egypt Standard Time",
+	"Africa/Casablanca":              "Morocco Standard Time",
+	"Africa/Ceuta":                   "Romance Standard Time",
+	"Africa/El_Aaiun":                "Morocco Standard Time",
+	"Africa/Johannesburg":            "South Africa Standard Time",
+	"Africa/Juba":                    "South Sudan Standard Time",
+	"Africa/Khartoum":                "Sudan Standard Time",
+	"Africa/Lagos":                   "W. Central Africa Standard Time",
+	"Africa/Maputo":                  "South Africa Standard Time",
+	"Africa/Monrovia":                "Greenwich Standard Time",
+	"Africa/Nairobi":                 "E. Africa Standard Time",
+	"Africa/Ndjamena":                "W. Central Africa Standard Time",
+	"Africa/Sao_Tome":                "Sao Tome Standard Time",
+	"Africa/Tripoli":                 "Libya Standard Time",
+	"Africa/Tunis":                   "W. Central Africa Standard Time",
+	"Africa/Windhoek":                "Namibia Standard Time",
+	"America/Adak":                   "Aleutian Standard Time",
+	"America/Anchorage":              "Alaskan Standard Time",
+	"America/Araguaina":              "Tocantins Standard Time",
+	"America/Argentina/Buenos_Aires": "Argentina Standard Time",
+	"America/Argentina/Catamarca":    "Argentina Standard Time",
+	"America/Argentina/Cordoba":      "Argentina Standard Time",
+	"America/Argentina/Jujuy":        "Argentina Standard Time",
+	"America/Argentina/La_Rioja":     "Argentina Standard Time",
+	"America/Argentina/Mendoza":      "Argentina Standard Time",
+	"America/Argentina/Rio_Gallegos": "Argentina Standard Time",
+	"America/Argentina/Salta":        "Argentina Standard Time",
+	"America/Argentina/San_Juan":     "Argentina Standard Time",
+	"America/Argentina/San_Luis":     "Argentina Standard Time",
+	"America/Argentina/Tucuman":      "Argentina Standard Time",
+	"America/Argentina/Ushuaia":      "Argentina Standard Time",
+	"America/Asuncion":               "Paraguay Standard Time",
+	"America/Bahia":                  "Bahia Standard Time",
+	"America/Bahia_Banderas":         "Central Standard Time (Mexico)",
+	"America/Barbados":               "SA Western Standard Time",
+	"America/Belem":                  "SA Eastern Standard Time",
+	"America/Belize":                 "Central America Standard Time",
+	"America/Boa_Vista":              "SA Western Standard Time",
+	"America/Bogota":                 "SA Pacific Standard Time",
+	"America/Boise":                  "Mountain Standard Time",
+	"America/Cambridge_Bay":          "Mountain Standard Time",
+	"America/Campo_Grande":           "Central Brazilian Standard Time",
+	"America/Cancun":                 "Eastern Standard Time (Mexico)",
+	"America/Caracas":                "Venezuela Standard Time",
+	"America/Cayenne":                "SA Eastern Standard Time",
+	"America/Chicago":                "Central Standard Time",
+	"America/Chihuahua":              "Central Standard Time (Mexico)",
+	"America/Ciudad_Juarez":          "Mountain Standard Time",
+	"America/Costa_Rica":             "Central America Standard Time",
+	"America/Coyhaique":              "Magallanes Standard Time",
+	"America/Cuiaba":                 "Central Brazilian Standard Time",
+	"America/Danmarkshavn":           "Greenwich Standard Time",
+	"America/Dawson":                 "Yukon Standard Time",
+	"America/Dawson_Creek":           "US Mountain Standard Time",
+	"America/Denver":                 "Mountain Standard Time",
+	"America/Detroit":                "Eastern Standard Time",
+	"America/Edmonton":               "Mountain Standard Time",
+	"America/Eirunepe":               "SA Pacific Standard Time",
+	"America/El_Salvador":            "Central America Standard Time",
+	"America/Fort_Nelson":            "US Mountain Standard Time",
+	"America/Fortaleza":              "SA Eastern Standard Time",
+	"America/Glace_Bay":              "Atlantic Standard Time",
+	"America/Goose_Bay":              "Atlantic Standard Time",
+	"America/Grand_Turk":             "Turks And Caicos Standard Time",
+	"America/Guatemala":              "Central America Standard Time",
+	"America/Guayaquil":              "SA Pacific Standard Time",
+	"America/Guyana":                 "SA Western Standard Time",
+	"America/Halifax":                "Atlantic Standard Time",
+	"America/Havana":                 "Cuba Standard Time",
+	"America/Hermosillo":             "US Mountain Standard Time",
+	"America/Indiana/Indianapolis":   "US Eastern Standard Time",
+	"America/Indiana/Knox":           "Central Standard Time",
+	"America/Indiana/Marengo":        "US Eastern Standard Time",
+	"America/Indiana/Petersburg":     "Eastern Standard Time",
+	"America/Indiana/Tell_City":      "Central Standard Time",
+	"America/Indiana/Vevay":          "US Eastern Standard Time",
+	"America/Indiana/Vincennes":      "Eastern Standard Time",
+	"America/Indiana/Winamac":        "Eastern Standard Time",
+	"America/Inuvik":                 "Mountain Standard Time",
+	"America/Iqaluit":                "Eastern Standard Time",
+	"America/Jamaica":                "SA Pacific Standard Time",
+	"America/Juneau":                 "Alaskan Standard Time",
+	"America/Kentucky/Louisville":    "Eastern Standard Time",
+	"America/Kentucky/Monticello":    "Eastern Standard Time",
+	"America/La_Paz":                 "SA Western Standard Time",
+	"America/Lima":                   "SA Pacific Standard Time",
+	"America/Los_Angeles":            "Pacific Standard Time",
+	"America/Maceio":                 "SA Eastern Standard Time",
+	"America/Managua":                "Central America Standard Time",
+	"America/Manaus":                 "SA Western Standard Time",
+	"America/Martinique":             "SA Western Standard Time",
+	"America/Matamoros":              "Central Standard Time",
+	"America/Mazatlan":               "Mountain Standard Time (Mexico)",
+	"America/Menominee":              "Central Standard Time",
+	"America/Merida":                 "Central Standard Time (Mexico)",
+	"America/Metlakatla":             "Alaskan Standard Time",
+	"America/Mexico_City":            "Central Standard Time (Mexico)",
+	"America/Miquelon":               "Saint Pierre Standard Time",
+	"America/Moncton":                "Atlantic Standard Time",
+	"America/Monterrey":              "Central Standard Time (Mexico)",
+	"America/Montevideo":             "Montevideo Standard Time",
+	"America/New_York":               "Eastern Standard Time",
+	"America/Nome":                   "Alaskan Standard Time",
+	"America/Noronha":                "UTC-02",
+	"America/North_Dakota/Beulah":    "Central Standard Time",
+	"America/North_Dakota/Center":    "Central Standard Time",
+	"America/North_Dakota/New_Salem": "Central Standard Time",
+	"America/Nuuk":                   "Greenland Standard Time",
+	"America/Ojinaga":                "Central Standard Time",
+	"America/Panama":                 "SA Pacific Standard Time",
+	"America/Paramaribo":             "SA Eastern Standard Time",
+	"America/Phoenix":                "US Mountain Standard Time",
+	"America/Port-au-Prince":         "Haiti Standard Time",
+	"America/Porto_Velho":            "SA Western Standard Time",
+	"America/Puerto_Rico":            "SA Western Standard Time",
+	"America/Punta_Arenas":           "Magallanes Standard Time",
+	"America/Rankin_Inlet":           "Central Standard Time",
+	"America/Recife":                 "SA Eastern Standard Time",
+	"America/Regina":                 "Canada Central Standard Time",
+	"America/Resolute":               "Central Standard Time",
+	"America/Rio_Branco":             "SA Pacific Standard Time",
+	"America/Santarem":               "SA Eastern Standard Time",
+	"America/Santiago":               "Pacific SA Standard Time",
+	"America/Santo_Domingo":          "SA Western Standard Time",
+	"America/Sao_Paulo":              "E. South America Standard Time",
+	"America/Scoresbysund":           "Azores Standard Time",
+	"America/Sitka":                  "Alaskan Standard Time",
+	"America/St_Johns":               "Newfoundland Standard Time",
+	"America/Swift_Current":          "Canada Central Standard Time",
+	"America/Tegucigalpa":            "Central America Standard Time",
+	"America/Thule":                  "Atlantic Standard Time",
+	"America/Tijuana":                "Pacific Standard Time (Mexico)",
+	"America/Toronto":                "Eastern Standard Time",
+	"America/Vancouver":              "Pacific Standard Time",
+	"America/Whitehorse":             "Yukon Standard Time",
+	"America/Winnipeg":               "Central Standard Time",
+	"America/Yakutat":                "Alaskan Standard Time",
+	"Antarctica/Casey":               "Central Pacific Standard Time",
+	"Antarctica/Davis":               "SE Asia Standard Time",
+	"Antarctica/Macquarie":           "Tasmania Standard Time",
+	"Antarctica/Mawson":              "West Asia Standard Time",
+	"Antarctica/Palmer":              "SA Eastern Standard Time",
+	"Antarctica/Rothera":             "SA Eastern Standard Time",
+	"Antarctica/Vostok":              "Central Asia Standard Time",
+	"Asia/Almaty":                    "West Asia Standard Time",
+	"Asia/Amman":                     "Jordan Standard Time",
+	"Asia/Anadyr":                    "Russia Time Zone 11",
+	"Asia/Aqtau":                     "West Asia Standard Time",
+	"Asia/Aqtobe":                    "West Asia Standard Time",
+	"Asia/Ashgabat":                  "West Asia Standard Time",
+	"Asia/Atyrau":                    "West Asia Standard Time",
+	"Asia/Baghdad":                   "Arabic Standard Time",
+	"Asia/Baku":                      "Azerbaijan Standard Time",
+	"Asia/Bangkok":                   "SE Asia Standard Time",
+	"Asia/Barnaul":                   "Altai Standard Time",
+	"Asia/Beirut":                    "Middle East Standard Time",
+	"Asia/Bishkek":                   "Central Asia Standard Time",
+	"Asia/Chita":                     "Transbaikal Standard Time",
+	"Asia/Colombo":                   "Sri Lanka Standard Time",
+	"Asia/Damascus":                  "Syria Standard Time",
+	"Asia/Dhaka":                     "Bangladesh Standard Time",
+	"Asia/Dili":                      "Tokyo Standard Time",
+	"Asia/Dubai":                     "Arabian Standard Time",
+	"Asia/Dushanbe":                  "West Asia Standard Time",
+	"Asia/Famagusta":                 "GTB Standard Time",
+	"Asia/Gaza":                      "West Bank Standard Time",
+	"Asia/Hebron":                    "West Bank Standard Time",
+	"Asia/Ho_Chi_Minh":               "SE Asia Standard Time",
+	"Asia/Hong_Kong":                 "China Standard Time",
+	"Asia/Hovd":                      "W. Mongolia Standard Time",
+	"Asia/Irkutsk":                   "North Asia East Standard Time",
+	"Asia/Jakarta":                   "SE Asia Standard Time",
+	"Asia/Jayapura":                  "Tokyo Standard Time",
+	"Asia/Jerusalem":                 "Israel Standard Time",
+	"Asia/Kabul":                     "Afghanistan Standard Time",
+	"Asia/Kamchatka":                 "Russia Time Zone 11",
+	"Asia/Karachi":                   "Pakistan Standard Time",
+	"Asia/Kathmandu":                 "Nepal Standard Time",
+	"Asia/Khandyga":                  "Yakutsk Standard Time",
+	"Asia/Kolkata":                   "India Standard Time",
+	"Asia/Krasnoyarsk":               "North Asia Standard Time",
+	"Asia/Kuching":                   "Singapore Standard Time",
+	"Asia/Macau":                     "China Standard Time",
+	"Asia/Magadan":                   "Magadan Standard Time",
+	"Asia/Makassar":                  "Singapore Standard Time",
+	"Asia/Manila":                    "Singapore Standard Time",
+	"Asia/Nicosia":                   "GTB Standard Time",
+	"Asia/Novokuznetsk":              "North Asia Standard Time",
+	"Asia/Novosibirsk":               "N. Central Asia Standard Time",
+	"Asia/Omsk":                      "Omsk Standard Time",
+	"Asia/Oral":                      "West Asia Standard Time",
+	"Asia/Pontianak":                 "SE Asia Standard Time",
+	"Asia/Pyongyang":                 "North Korea Standard Time",
+	"Asia/Qatar":                     "Arab Standard Time",
+	"Asia/Qostanay":                  "West Asia Standard Time",
+	"Asia/Qyzylorda":                 "Qyzylorda Standard Time",
+	"Asia/Riyadh":                    "Arab Standard Time",
+	"Asia/Sakhalin":                  "Sakhalin Standard Time",
+	"Asia/Samarkand":                 "West Asia Standard Time",
+	"Asia/Seoul":                     "Korea Standard Time",
+	"Asia/Shanghai":                  "China Standard Time",
+	"Asia/Singapore":                 "Singapore Standard Time",
+	"Asia/Srednekolymsk":             "Russia Time Zone 10",
+	"Asia/Taipei":                    "Taipei Standard Time",
+	"Asia/Tashkent":                  "West Asia Standard Time",
+	"Asia/Tbilisi":                   "Georgian Standard Time",
+	"Asia/Tehran":                    "Iran Standard Time",
+	"Asia/Thimphu":                   "Bangladesh Standard Time",
+	"Asia/Tokyo":                     "Tokyo Standard Time",
+	"Asia/Tomsk":                     "Tomsk Standard Time",
+	"Asia/Ulaanbaatar":               "Ulaanbaatar Standard Time",
+	"Asia/Urumqi":                    "Central Asia Standard Time",
+	"Asia/Ust-Nera":                  "Vladivostok Standard Time",
+	"Asia/Vladivostok":               "Vladivostok Standard Time",
+	"Asia/Yakutsk":                   "Yakutsk Standard Time",
+	"Asia/Yangon":                    "Myanmar Standard Time",
+	"Asia/Yekaterinburg":             "Ekaterinburg Standard Time",
+	"Asia/Yerevan":                   "Caucasus Standard Time",
+	"Atlantic/Azores":                "Azores Standard Time",
+	"Atlantic/Bermuda":               "Atlantic Standard Time",
+	"Atlantic/Canary":                "GMT Standard Time",
+	"Atlantic/Cape_Verde":            "Cape Verde Standard Time",
+	"Atlantic/Faroe":                 "GMT Standard Time",
+	"Atlantic/Madeira":               "GMT Standard Time",
+	"Atlantic/South_Georgia":         "UTC-02",
+	"Atlantic/Stanley":               "SA Eastern Standard Time",
+	"Australia/Adelaide":             "Cen. Australia Standard Time",
+	"Australia/Brisbane":             "E. Australia Standard Time",
+	"Australia/Broken_Hill":          "Cen. Australia Standard Time",
+	"Australia/Darwin":               "AUS Central Standard Time",
+	"Australia/Eucla":                "Aus Central W. Standard Time",
+	"Australia/Hobart":               "Tasmania Standard Time",
+	"Australia/Lindeman":             "E. Australia Standard Time",
+	"Australia/Lord_Howe":            "Lord Howe Standard Time",
+	"Australia/Melbourne":            "AUS Eastern Standard Time",
+	"Australia/Perth":                "W. Australia Standard Time",
+	"Australia/Sydney":               "AUS Eastern Standard Time",
+	"Europe/Andorra":                 "W. Europe Standard Time",
+	"Europe/Astrakhan":               "Astrakhan Standard Time",
+	"Europe/Athens":                  "GTB Standard Time",
+	"Europe/Belgrade":                "Central Europe Standard Time",
+	"Europe/Berlin":                  "W. Europe Standard Time",
+	"Europe/Brussels":                "Romance Standard Time",
+	"Europe/Bucharest":               "GTB Standard Time",
+	"Europe/Budapest":                "Central Europe Standard Time",
+	"Europe/Chisinau":                "E. Europe Standard Time",
+	"Europe/Dublin":                  "GMT Standard Time",
+	"Europe/Gibraltar":               "W. Europe Standard Time",
+	"Europe/Helsinki":                "FLE Standard Time",
+	"Europe/Istanbul":                "Turkey Standard Time",
+	"Europe/Kaliningrad":             "Kaliningrad Standard Time",
+	"Europe/Kirov":                   "Russian Standard Time",
+	"Europe/Kyiv":                    "FLE Standard Time",
+	"Europe/Lisbon":                  "GMT Standard Time",
+	"Europe/London":                  "GMT Standard Time",
+	"Europe/Madrid":                  "Romance Standard Time",
+	"Europe/Malta":                   "W. Europe Standard Time",
+	"Europe/Minsk":                   "Belarus Standard Time",
+	"Europe/Moscow":                  "Russian Standard Time",
+	"Europe/Paris":                   "Romance Standard Time",
+	"Europe/Prague":                  "Central Europe Standard Time",
+	"Europe/Riga":                    "FLE Standard Time",
+	"Europe/Rome":                    "W. Europe Standard Time",
+	"Europe/Samara":                  "Russia Time Zone 3",
+	"Europe/Saratov":                 "Saratov Standard Time",
+	"Europe/Simferopol":              "Russian Standard Time",
+	"Europe/Sofia":                   "FLE Standard Time",
+	"Europe/Tallinn":                 "FLE Standard Time",
+	"Europe/Tirane":                  "Central Europe Standard Time",
+	"Europe/Ulyanovsk":               "Astrakhan Standard Time",
+	"Europe/Vienna":                  "W. Europe Standard Time",
+	"Europe/Vilnius":                 "FLE Standard Time",
+	"Europe/Volgograd":               "Volgograd Standard Time",
+	"Europe/Warsaw":                  "Central European Standard Time",
+	"Europe/Zurich":                  "W. Europe Standard Time",
+	"Indian/Chagos":                  "Central Asia Standard Time",
+	"Indian/Maldives":                "West Asia Standard Time",
+	"Indian/Mauritius":               "Mauritius Standard Time",
+	"Pacific/Apia":                   "Samoa Standard Time",
+	"Pacific/Auckland":               "New Zealand Standard Time",
+	"Pacific/Bougainville":           "Bougainville Standard Time",
+	"Pacific/Chatham":                "Chatham Islands Standard Time",
+	"Pacific/Easter":                 "Easter Island Standard Time",
+	"Pacific/Efate":                  "Central Pacific Standard Time",
+	"Pacific/Fakaofo":                "UTC+13",
+	"Pacific/Fiji":                   "Fiji Standard Time",
+	"Pacific/Galapagos":              "Central America Standard Time",
+	"Pacific/Gambier":                "UTC-09",
+	"Pacific/Guadalcanal":            "Central Pacific Standard Time",
+	"Pacific/Guam":                   "West Pacific Standard Time",
+	"Pacific/Honolulu":               "Hawaiian Standard Time",
+	"Pacific/Kanton":                 "UTC+13",
+	"Pacific/Kiritimati":             "Line Islands Standard Time",
+	"Pacific/Kosrae":                 "Central Pacific Standard Time",
+	"Pacific/Kwajalein":              "UTC+12",
+	"Pacific/Marquesas":              "Marquesas Standard Time",
+	"Pacific/Nauru":                  "UTC+12",
+	"Pacific/Niue":                   "UTC-11",
+	"Pacific/Norfolk":                "Norfolk Standard Time",
+	"Pacific/Noumea":                 "Central Pacific Standard Time",
+	"Pacific/Pago_Pago":              "UTC-11",
+	"Pacific/Palau":                  "Tokyo Standard Time",
+	"Pacific/Pitcairn":               "UTC-08",
+	"Pacific/Port_Moresby":           "West Pacific Standard Time",
+	"Pacific/Rarotonga":              "Hawaiian Standard Time",
+	"Pacific/Tahiti":                 "Hawaiian Standard Time",
+	"Pacific/Tarawa":                 "UTC+12",
+	"Pacific/Tongatapu":              "Tonga Standard Time",
+	"UTC":                            "UTC",
+}
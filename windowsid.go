@@ -0,0 +1,49 @@
+package tz
+
+// FromWindows gets the zone for a Windows timezone ID, such as "W. Europe
+// Standard Time", as used by Exchange, Outlook, and the Windows registry.
+//
+// The territory is a country code, and is used to get the zone for that
+// country if there is one ("W. Europe Standard Time" is Europe/Amsterdam for
+// NL); the default zone for the ID is used if territory is blank or has no
+// specific mapping. IDs for a fixed offset such as "UTC-02" have no default
+// zone, and need a territory.
+//
+// New() and Scan() also accept Windows IDs, with the country code as the
+// territory.
+func FromWindows(id, territory string) (*Zone, error) {
+	zone, ok := windowsZone(id, territory)
+	if !ok {
+		return nil, &LookupError{CountryCode: territory, Zone: id, Err: ErrUnknownZone}
+	}
+	z, _, err := resolve(territory, zone)
+	return z, err
+}
+
+// WindowsID gets the Windows timezone ID for this zone, or an empty string if
+// there isn't one.
+//
+// The ID for the zone's country is used if it's different: NL.Europe/Brussels
+// is "W. Europe Standard Time", and BE.Europe/Brussels is "Romance Standard
+// Time".
+func (t *Zone) WindowsID() string {
+	if t == nil {
+		return ""
+	}
+	if id, ok := territoryWindows[t.CountryCode][t.Zone]; ok {
+		return id
+	}
+	return zoneWindows[t.Zone]
+}
+
+func windowsZone(id, territory string) (string, bool) {
+	m, ok := windowsZones[id]
+	if !ok {
+		return "", false
+	}
+	if z, ok := m[territory]; ok && territory != "" {
+		return z, true
+	}
+	z, ok := m["001"]
+	return z, ok
+}
@@ -0,0 +1,116 @@
+package tz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromWindows(t *testing.T) {
+	tests := []struct {
+		id, territory string
+		want          string
+		wantErr       error
+	}{
+		{"W. Europe Standard Time", "", "DE.Europe/Berlin", nil},
+		{"India Standard Time", "", "IN.Asia/Kolkata", nil},
+		{"US Eastern Standard Time", "", "US.America/Indiana/Indianapolis", nil},
+		{"UTC", "", ".UTC", nil},
+		{"UTC-02", "BR", "BR.America/Noronha", nil},
+		{"UTC-02", "GS", "GS.Atlantic/South_Georgia", nil},
+		{"W. Europe Standard Time", "NL", "NL.Europe/Brussels", nil},
+		{"W. Europe Standard Time", "AT", "AT.Europe/Vienna", nil},
+		{"Romance Standard Time", "BE", "BE.Europe/Brussels", nil},
+		{"Romance Standard Time", "", "FR.Europe/Paris", nil},
+		{"W. Europe Standard Time", "XX", "DE.Europe/Berlin", nil},
+
+		{"UTC-02", "", "", ErrUnknownZone},
+		{"Nonexistent Standard Time", "", "", ErrUnknownZone},
+		{"Europe/Berlin", "", "", ErrUnknownZone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id+tt.territory, func(t *testing.T) {
+			z, err := FromWindows(tt.id, tt.territory)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrong error\nhave: %v\nwant: %v", err, tt.wantErr)
+			}
+			if have := z.String(); have != tt.want {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindowsNew(t *testing.T) {
+	z, r, err := Resolve("", "Korea Standard Time")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.String() != "KR.Asia/Seoul" || r.Method != Windows {
+		t.Errorf("%s %s", z, r.Method)
+	}
+
+	var s Zone
+	for _, v := range []string{"W. Europe Standard Time", "DE.W. Europe Standard Time"} {
+		err = s.Scan(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.String() != "DE.Europe/Berlin" {
+			t.Errorf("%q: %s", v, s.String())
+		}
+	}
+
+	_, err = NewStrict("", "Korea Standard Time")
+	if !errors.Is(err, ErrNotExact) {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+func TestWindowsID(t *testing.T) {
+	tests := []struct {
+		zone *Zone
+		want string
+	}{
+		{MustNew("", "Europe/Berlin"), "W. Europe Standard Time"},
+		{MustNew("", "Asia/Kolkata"), "India Standard Time"},
+		{UTC, "UTC"},
+		{MustNew("", "Europe/Brussels"), "Romance Standard Time"},
+		{MustNew("NL", "Europe/Brussels"), "W. Europe Standard Time"},
+		{MustNew("DK", "Europe/Berlin"), "Romance Standard Time"},
+		{MustNew("", "Europe/Vienna"), "W. Europe Standard Time"},
+		{MustNew("", "America/Noronha"), "UTC-02"},
+		{MustNew("", "Antarctica/Troll"), ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone.String(), func(t *testing.T) {
+			if have := tt.zone.WindowsID(); have != tt.want {
+				t.Errorf("\nhave: %q\nwant: %q", have, tt.want)
+			}
+		})
+	}
+
+	for zone, id := range zoneWindows {
+		cc := MustNew("", zone).CountryCode
+		if _, ok := territoryWindows[cc][zone]; ok {
+			continue
+		}
+		z, err := FromWindows(id, cc)
+		if err != nil || z.WindowsID() != id {
+			t.Errorf("%s → %s → %s %v", zone, id, z, err)
+		}
+	}
+	for id, m := range windowsZones {
+		for cc := range m {
+			if cc == "001" || cc == "ZZ" {
+				continue
+			}
+			z, err := FromWindows(id, cc)
+			if err != nil || z.WindowsID() != id {
+				t.Errorf("%s %s → %s → %s %v", cc, id, z, z.WindowsID(), err)
+			}
+		}
+	}
+}